@@ -0,0 +1,144 @@
+// Command importquestions imports questions from a CSV or a JSON file, see the importer package for the file format.
+//
+// Usage:
+//
+//	importquestions -file questions.csv [-images images.zip] [-format csv|json] [-dry-run]
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/Kichiyaki/goutil/envutil"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/zdam-egzamin-zawodowy/backend/cmd/internal"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/postgres"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question/importer"
+	questionrepository "github.com/zdam-egzamin-zawodowy/backend/internal/question/repository"
+	questionusecase "github.com/zdam-egzamin-zawodowy/backend/internal/question/usecase"
+)
+
+func main() {
+	filePath := flag.String("file", "", "path to the CSV or JSON file with questions")
+	imagesPath := flag.String("images", "", "path to the zip archive with images (optional)")
+	format := flag.String("format", "", "format of the file (csv or json), guessed from the extension by default")
+	dryRun := flag.Bool("dry-run", false, "validate the questions without saving them")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := internal.LoadENVFiles(); err != nil {
+		logrus.Fatal("internal.LoadENVFiles", err)
+	}
+
+	result, err := importQuestions(*filePath, *imagesPath, model.QuestionImportFormat(*format), *dryRun)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	printResult(result)
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func importQuestions(
+	filePath, imagesPath string,
+	format model.QuestionImportFormat,
+	dryRun bool,
+) (*model.QuestionImportResult, error) {
+	if format == "" {
+		format, _ = importer.FormatFromFilename(filePath)
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't open the file")
+	}
+	defer f.Close()
+
+	var images *zip.Reader
+	if imagesPath != "" {
+		archive, err := zip.OpenReader(imagesPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "Couldn't open the archive")
+		}
+		defer archive.Close()
+		images = &archive.Reader
+	}
+
+	rows, err := importer.Parse(format, f, images)
+	if err != nil {
+		return nil, err
+	}
+
+	dbConn, err := postgres.Connect(&postgres.Config{
+		LogQueries: envutil.GetenvBool("LOG_DB_QUERIES"),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't connect to the db")
+	}
+	defer dbConn.Close()
+
+	fileStorage, err := internal.NewFileStorage()
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't create the file storage")
+	}
+	imageProcessor, err := internal.NewImageProcessor()
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't create the image processor")
+	}
+
+	questionRepository, err := questionrepository.NewPGRepository(&questionrepository.PGRepositoryConfig{
+		DB:             dbConn,
+		FileStorage:    fileStorage,
+		ImageProcessor: imageProcessor,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "questionRepository")
+	}
+	questionUsecase, err := questionusecase.New(&questionusecase.Config{
+		QuestionRepository: questionRepository,
+		ImageProcessor:     imageProcessor,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "questionUsecase")
+	}
+
+	return questionUsecase.Import(context.Background(), &question.ImportConfig{
+		Rows:   rows,
+		DryRun: dryRun,
+	})
+}
+
+func printResult(result *model.QuestionImportResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ROW\tSTATUS\tQUESTION ID\tERROR")
+	for _, row := range result.Rows {
+		questionID := "-"
+		if row.QuestionID != nil {
+			questionID = fmt.Sprint(*row.QuestionID)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", row.Row, row.Status, questionID, row.Error)
+	}
+	w.Flush()
+
+	fmt.Printf(
+		"\ntotal: %d, created: %d, duplicates: %d, failed: %d, dry run: %t\n",
+		result.Total,
+		result.Created,
+		result.Duplicates,
+		result.Failed,
+		result.DryRun,
+	)
+}
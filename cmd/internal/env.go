@@ -3,7 +3,9 @@ package internal
 import (
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
+	"os"
 	"strings"
+	"time"
 )
 
 func LoadENVFiles() error {
@@ -15,3 +17,11 @@ func LoadENVFiles() error {
 
 	return nil
 }
+
+func GetenvDuration(key string, defaultValue time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return defaultValue
+	}
+	return d
+}
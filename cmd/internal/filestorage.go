@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"github.com/Kichiyaki/goutil/envutil"
+	"github.com/pkg/errors"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+)
+
+const (
+	fileStorageDriverLocal = "local"
+	fileStorageDriverS3    = "s3"
+)
+
+func NewFileStorage() (fstorage.FileStorage, error) {
+	urlCfg := &fstorage.URLConfig{
+		BaseURL:    envutil.GetenvString("FILE_STORAGE_PUBLIC_URL"),
+		SigningKey: []byte(envutil.GetenvString("FILE_STORAGE_URL_SIGNING_KEY")),
+		Lifetime:   GetenvDuration("FILE_STORAGE_URL_LIFETIME", fstorage.DefaultURLLifetime),
+	}
+	switch driver := envutil.GetenvString("FILE_STORAGE_DRIVER"); driver {
+	case fileStorageDriverS3:
+		storage, err := fstorage.NewS3Storage(&fstorage.S3Config{
+			Endpoint:        envutil.GetenvString("S3_ENDPOINT"),
+			Region:          envutil.GetenvString("S3_REGION"),
+			Bucket:          envutil.GetenvString("S3_BUCKET"),
+			AccessKeyID:     envutil.GetenvString("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: envutil.GetenvString("S3_SECRET_ACCESS_KEY"),
+			Prefix:          envutil.GetenvString("S3_PREFIX"),
+			DisableSSL:      envutil.GetenvBool("S3_DISABLE_SSL"),
+			URL:             urlCfg,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "fstorage.NewS3Storage")
+		}
+		return storage, nil
+	case fileStorageDriverLocal, "":
+		return fstorage.New(&fstorage.Config{
+			BasePath: envutil.GetenvString("FILE_STORAGE_PATH"),
+			URL:      urlCfg,
+		}), nil
+	default:
+		return nil, errors.Errorf("unknown file storage driver: %s", driver)
+	}
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Kichiyaki/goutil/envutil"
+	"github.com/pkg/errors"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/imageproc"
+)
+
+func NewImageProcessor() (*imageproc.Processor, error) {
+	var widths []int
+	for _, width := range strings.Split(envutil.GetenvString("IMAGE_VARIANT_WIDTHS"), ",") {
+		width = strings.TrimSpace(width)
+		if width == "" {
+			continue
+		}
+		w, err := strconv.Atoi(width)
+		if err != nil || w <= 0 {
+			return nil, errors.Errorf("invalid image variant width: %s", width)
+		}
+		widths = append(widths, w)
+	}
+	return imageproc.New(&imageproc.Config{
+		MaxDimension:  envutil.GetenvInt("IMAGE_MAX_DIMENSION"),
+		MaxPixels:     envutil.GetenvInt("IMAGE_MAX_PIXELS"),
+		Quality:       envutil.GetenvInt("IMAGE_QUALITY"),
+		VariantWidths: widths,
+	}), nil
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"github.com/Kichiyaki/goutil/envutil"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/zdam-egzamin-zawodowy/backend/mailer"
+)
+
+const (
+	mailerDriverLog  = "log"
+	mailerDriverFile = "file"
+	mailerDriverSMTP = "smtp"
+)
+
+func NewMailer() (mailer.Mailer, error) {
+	switch driver := envutil.GetenvString("MAILER_DRIVER"); driver {
+	case mailerDriverSMTP:
+		m, err := mailer.NewSMTPMailer(&mailer.SMTPConfig{
+			Host:     envutil.GetenvString("SMTP_HOST"),
+			Port:     envutil.GetenvInt("SMTP_PORT"),
+			Username: envutil.GetenvString("SMTP_USERNAME"),
+			Password: envutil.GetenvString("SMTP_PASSWORD"),
+			From:     envutil.GetenvString("MAILER_FROM"),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "mailer.NewSMTPMailer")
+		}
+		return m, nil
+	case mailerDriverFile:
+		m, err := mailer.NewFileMailer(&mailer.FileConfig{
+			Path: envutil.GetenvString("MAILER_FILE_PATH"),
+			From: envutil.GetenvString("MAILER_FROM"),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "mailer.NewFileMailer")
+		}
+		return m, nil
+	case mailerDriverLog, "":
+		return mailer.NewLogMailer(logrus.WithField("package", "mailer")), nil
+	default:
+		return nil, errors.Errorf("unknown mailer driver: %s", driver)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"github.com/Kichiyaki/appmode"
 	"github.com/Kichiyaki/chilogrus"
 	"github.com/Kichiyaki/goutil/envutil"
@@ -18,19 +19,34 @@ import (
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/auth"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/contentbundle"
+	contentbundlehttpdelivery "github.com/zdam-egzamin-zawodowy/backend/internal/contentbundle/httpdelivery"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/filedeletion"
+	filedeletionrepository "github.com/zdam-egzamin-zawodowy/backend/internal/filedeletion/repository"
+	filedeletionworker "github.com/zdam-egzamin-zawodowy/backend/internal/filedeletion/worker"
 	graphqlhttpdelivery "github.com/zdam-egzamin-zawodowy/backend/internal/graphql/delivery/httpdelivery"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/directive"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/resolvers"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/imageproc"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/profession"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/session"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/statistics"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/user"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/usertoken"
 
 	"github.com/pkg/errors"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/auth/jwt"
 	authusecase "github.com/zdam-egzamin-zawodowy/backend/internal/auth/usecase"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/dataloader"
+	loginlimiterrepository "github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter/repository"
+	loginlimiterusecase "github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter/usecase"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/postgres"
 	professionrepository "github.com/zdam-egzamin-zawodowy/backend/internal/profession/repository"
 	professionusecase "github.com/zdam-egzamin-zawodowy/backend/internal/profession/usecase"
@@ -38,12 +54,24 @@ import (
 	qualificationusecase "github.com/zdam-egzamin-zawodowy/backend/internal/qualification/usecase"
 	questionrepository "github.com/zdam-egzamin-zawodowy/backend/internal/question/repository"
 	questionusecase "github.com/zdam-egzamin-zawodowy/backend/internal/question/usecase"
+	reviewrepository "github.com/zdam-egzamin-zawodowy/backend/internal/review/repository"
+	reviewusecase "github.com/zdam-egzamin-zawodowy/backend/internal/review/usecase"
+	sessionrepository "github.com/zdam-egzamin-zawodowy/backend/internal/session/repository"
+	sessionusecase "github.com/zdam-egzamin-zawodowy/backend/internal/session/usecase"
+	sharedtestrepository "github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest/repository"
+	sharedtestusecase "github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest/usecase"
+	statisticsrepository "github.com/zdam-egzamin-zawodowy/backend/internal/statistics/repository"
+	statisticsusecase "github.com/zdam-egzamin-zawodowy/backend/internal/statistics/usecase"
+	testattemptrepository "github.com/zdam-egzamin-zawodowy/backend/internal/testattempt/repository"
+	testattemptusecase "github.com/zdam-egzamin-zawodowy/backend/internal/testattempt/usecase"
 	userrepository "github.com/zdam-egzamin-zawodowy/backend/internal/user/repository"
 	userusecase "github.com/zdam-egzamin-zawodowy/backend/internal/user/usecase"
+	usertokenrepository "github.com/zdam-egzamin-zawodowy/backend/internal/usertoken/repository"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/mailer"
 
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -65,9 +93,15 @@ func main() {
 	}
 	defer sentry.Flush(2 * time.Second)
 
-	fileStorage := fstorage.New(&fstorage.Config{
-		BasePath: envutil.GetenvString("FILE_STORAGE_PATH"),
-	})
+	fileStorage, err := internal.NewFileStorage()
+	if err != nil {
+		logrus.Fatal(errors.Wrap(err, "Couldn't create the file storage"))
+	}
+
+	imageProcessor, err := internal.NewImageProcessor()
+	if err != nil {
+		logrus.Fatal(errors.Wrap(err, "Couldn't create the image processor"))
+	}
 
 	dbConn, err := postgres.Connect(&postgres.Config{
 		LogQueries: envutil.GetenvBool("LOG_DB_QUERIES"),
@@ -76,19 +110,40 @@ func main() {
 		logrus.Fatal(errors.Wrap(err, "Couldn't connect to the db"))
 	}
 
-	repos, err := prepareRepositories(dbConn, fileStorage)
+	repos, err := prepareRepositories(dbConn, fileStorage, imageProcessor)
 	if err != nil {
 		logrus.Fatal(err)
 	}
 
-	ucases, err := prepareUsecases(repos)
+	m, err := internal.NewMailer()
+	if err != nil {
+		logrus.Fatal(errors.Wrap(err, "Couldn't create the mailer"))
+	}
+
+	ucases, err := prepareUsecases(repos, m, imageProcessor)
 	if err != nil {
 		logrus.Fatal(err)
 	}
 
+	fileDeletionWorker, err := filedeletionworker.New(&filedeletionworker.Config{
+		FileDeletionRepository: repos.fileDeletionRepository,
+		FileStorage:            fileStorage,
+		Interval:               internal.GetenvDuration("FILE_DELETION_INTERVAL", filedeletion.DefaultInterval),
+	})
+	if err != nil {
+		logrus.Fatal(errors.Wrap(err, "Couldn't create the file deletion worker"))
+	}
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		fileDeletionWorker.Run(workerCtx)
+	}()
+
 	srv := &http.Server{
 		Addr:    ":8080",
-		Handler: prepareRouter(repos, ucases),
+		Handler: prepareRouter(repos, ucases, fileStorage),
 	}
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -97,16 +152,23 @@ func main() {
 	}()
 	logrus.Info("Server is listening on the port 8080")
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	logrus.Info("Shutdown signal received, exiting...")
+	stopWorker()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
 		logrus.Fatalln("Server Shutdown:", err)
 	}
+
+	// the worker may be in the middle of a batch, the connection can be closed only after it has returned
+	<-workerDone
+	if err := dbConn.Close(); err != nil {
+		logrus.Warn(errors.Wrap(err, "Couldn't close the db connection"))
+	}
 }
 
 func prepareLogger() {
@@ -132,9 +194,21 @@ type repositories struct {
 	professionRepository    profession.Repository
 	qualificationRepository qualification.Repository
 	questionRepository      question.Repository
+	sessionRepository       session.Repository
+	userTokenRepository     usertoken.Repository
+	loginLimiterRepository  loginlimiter.Repository
+	testAttemptRepository   testattempt.Repository
+	statisticsRepository    statistics.Repository
+	reviewRepository        review.Repository
+	sharedTestRepository    sharedtest.Repository
+	fileDeletionRepository  filedeletion.Repository
 }
 
-func prepareRepositories(dbConn *pg.DB, fileStorage fstorage.FileStorage) (*repositories, error) {
+func prepareRepositories(
+	dbConn *pg.DB,
+	fileStorage fstorage.FileStorage,
+	imageProcessor *imageproc.Processor,
+) (*repositories, error) {
 	var err error
 	repos := &repositories{}
 
@@ -160,13 +234,77 @@ func prepareRepositories(dbConn *pg.DB, fileStorage fstorage.FileStorage) (*repo
 	}
 
 	repos.questionRepository, err = questionrepository.NewPGRepository(&questionrepository.PGRepositoryConfig{
-		DB:          dbConn,
-		FileStorage: fileStorage,
+		DB:             dbConn,
+		FileStorage:    fileStorage,
+		ImageProcessor: imageProcessor,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "questionRepository")
 	}
 
+	repos.sessionRepository, err = sessionrepository.NewPGRepository(&sessionrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessionRepository")
+	}
+
+	repos.userTokenRepository, err = usertokenrepository.NewPGRepository(&usertokenrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "userTokenRepository")
+	}
+
+	repos.testAttemptRepository, err = testattemptrepository.NewPGRepository(&testattemptrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "testAttemptRepository")
+	}
+
+	repos.statisticsRepository, err = statisticsrepository.NewPGRepository(&statisticsrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "statisticsRepository")
+	}
+
+	repos.reviewRepository, err = reviewrepository.NewPGRepository(&reviewrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reviewRepository")
+	}
+
+	repos.sharedTestRepository, err = sharedtestrepository.NewPGRepository(&sharedtestrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sharedTestRepository")
+	}
+
+	switch driver := envutil.GetenvString("LOGIN_LIMITER_DRIVER"); driver {
+	case "", "memory":
+		repos.loginLimiterRepository = loginlimiterrepository.NewMemoryRepository()
+	case "postgres":
+		repos.loginLimiterRepository, err = loginlimiterrepository.NewPGRepository(&loginlimiterrepository.PGRepositoryConfig{
+			DB: dbConn,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "loginLimiterRepository")
+		}
+	default:
+		return nil, errors.Errorf("loginLimiterRepository: unsupported driver '%s'", driver)
+	}
+
+	repos.fileDeletionRepository, err = filedeletionrepository.NewPGRepository(&filedeletionrepository.PGRepositoryConfig{
+		DB: dbConn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fileDeletionRepository")
+	}
+
 	return repos, nil
 }
 
@@ -176,25 +314,58 @@ type usecases struct {
 	professionUsecase    profession.Usecase
 	qualificationUsecase qualification.Usecase
 	questionUsecase      question.Usecase
+	sessionUsecase       session.Usecase
+	loginLimiterUsecase  loginlimiter.Usecase
+	testAttemptUsecase   testattempt.Usecase
+	statisticsUsecase    statistics.Usecase
+	reviewUsecase        review.Usecase
+	sharedTestUsecase    sharedtest.Usecase
 }
 
-func prepareUsecases(repos *repositories) (*usecases, error) {
+func prepareUsecases(repos *repositories, m mailer.Mailer, imageProcessor *imageproc.Processor) (*usecases, error) {
 	var err error
 	ucases := &usecases{}
 
-	ucases.authUsecase, err = authusecase.New(&authusecase.Config{
+	ucases.userUsecase, err = userusecase.New(&userusecase.Config{
 		UserRepository: repos.userRepository,
-		TokenGenerator: jwt.NewTokenGenerator(envutil.GetenvString("ACCESS_SECRET")),
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "authUsecase")
+		return nil, errors.Wrap(err, "userUsecase")
 	}
 
-	ucases.userUsecase, err = userusecase.New(&userusecase.Config{
-		UserRepository: repos.userRepository,
+	ucases.loginLimiterUsecase, err = loginlimiterusecase.New(&loginlimiterusecase.Config{
+		LoginLimiterRepository: repos.loginLimiterRepository,
+		ResetAfter:             internal.GetenvDuration("LOGIN_LIMITER_RESET_AFTER", loginlimiter.DefaultResetAfter),
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "userUsecase")
+		return nil, errors.Wrap(err, "loginLimiterUsecase")
+	}
+
+	ucases.authUsecase, err = authusecase.New(&authusecase.Config{
+		UserRepository:       repos.userRepository,
+		UserUsecase:          ucases.userUsecase,
+		SessionRepository:    repos.sessionRepository,
+		UserTokenRepository:  repos.userTokenRepository,
+		LoginLimiterUsecase:  ucases.loginLimiterUsecase,
+		TokenGenerator:       jwt.NewTokenGenerator(envutil.GetenvString("ACCESS_SECRET")),
+		Mailer:               m,
+		AccountActivationURL: envutil.GetenvString("ACCOUNT_ACTIVATION_URL"),
+		PasswordResetURL:     envutil.GetenvString("PASSWORD_RESET_URL"),
+		AccessTokenLifetime: internal.GetenvDuration(
+			"ACCESS_TOKEN_LIFETIME",
+			auth.DefaultAccessTokenLifetime,
+		),
+		RefreshTokenLifetime: internal.GetenvDuration(
+			"REFRESH_TOKEN_LIFETIME",
+			session.DefaultLifetime,
+		),
+		StaySignedInRefreshTokenLifetime: internal.GetenvDuration(
+			"STAY_SIGNED_IN_REFRESH_TOKEN_LIFETIME",
+			session.StaySignedInLifetime,
+		),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "authUsecase")
 	}
 
 	ucases.professionUsecase, err = professionusecase.New(&professionusecase.Config{
@@ -213,15 +384,54 @@ func prepareUsecases(repos *repositories) (*usecases, error) {
 
 	ucases.questionUsecase, err = questionusecase.New(&questionusecase.Config{
 		QuestionRepository: repos.questionRepository,
+		ImageProcessor:     imageProcessor,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "questionUsecase")
 	}
 
+	ucases.sessionUsecase, err = sessionusecase.New(&sessionusecase.Config{
+		SessionRepository: repos.sessionRepository,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessionUsecase")
+	}
+
+	ucases.reviewUsecase, err = reviewusecase.New(&reviewusecase.Config{
+		ReviewRepository: repos.reviewRepository,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reviewUsecase")
+	}
+
+	ucases.testAttemptUsecase, err = testattemptusecase.New(&testattemptusecase.Config{
+		TestAttemptRepository: repos.testAttemptRepository,
+		QuestionUsecase:       ucases.questionUsecase,
+		QualificationUsecase:  ucases.qualificationUsecase,
+		ReviewUsecase:         ucases.reviewUsecase,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "testAttemptUsecase")
+	}
+
+	ucases.statisticsUsecase, err = statisticsusecase.New(&statisticsusecase.Config{
+		StatisticsRepository: repos.statisticsRepository,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "statisticsUsecase")
+	}
+
+	ucases.sharedTestUsecase, err = sharedtestusecase.New(&sharedtestusecase.Config{
+		SharedTestRepository: repos.sharedTestRepository,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sharedTestUsecase")
+	}
+
 	return ucases, nil
 }
 
-func prepareRouter(repos *repositories, ucases *usecases) *chi.Mux {
+func prepareRouter(repos *repositories, ucases *usecases, fileStorage fstorage.FileStorage) *chi.Mux {
 	r := chi.NewRouter()
 
 	sentryHandler := sentryhttp.New(sentryhttp.Options{
@@ -231,6 +441,7 @@ func prepareRouter(repos *repositories, ucases *usecases) *chi.Mux {
 	})
 
 	r.Use(chimiddleware.RealIP)
+	r.Use(middleware.ClientInfoToContext())
 	if envutil.GetenvBool("ENABLE_ACCESS_LOG") {
 		r.Use(chilogrus.Logger(logrus.StandardLogger()))
 	}
@@ -265,13 +476,49 @@ func prepareRouter(repos *repositories, ucases *usecases) *chi.Mux {
 				ProfessionUsecase:    ucases.professionUsecase,
 				QualificationUsecase: ucases.qualificationUsecase,
 				QuestionUsecase:      ucases.questionUsecase,
+				SessionUsecase:       ucases.sessionUsecase,
+				LoginLimiterUsecase:  ucases.loginLimiterUsecase,
+				TestAttemptUsecase:   ucases.testAttemptUsecase,
+				StatisticsUsecase:    ucases.statisticsUsecase,
+				ReviewUsecase:        ucases.reviewUsecase,
+				SharedTestUsecase:    ucases.sharedTestUsecase,
+				FileStorage:          fileStorage,
 			},
 			Directive: &directive.Directive{},
 		})
 		if err != nil {
 			log.Fatalln(err)
 		}
+
+		bundleBuilder, err := contentbundle.NewBuilder(&contentbundle.Config{
+			QuestionUsecase: ucases.questionUsecase,
+			FileStorage:     fileStorage,
+			PrivateKey:      prepareContentBundleKey(),
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
+		err = contentbundlehttpdelivery.Attach(r, contentbundlehttpdelivery.Config{
+			Builder:              bundleBuilder,
+			QualificationUsecase: ucases.qualificationUsecase,
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
 	})
 
 	return r
 }
+
+func prepareContentBundleKey() ed25519.PrivateKey {
+	encoded := envutil.GetenvString("CONTENT_BUNDLE_SIGNING_KEY")
+	if encoded == "" {
+		// the app pins the public key, so the bundles signed with an ephemeral key couldn't be verified after a restart
+		log.Fatalln("CONTENT_BUNDLE_SIGNING_KEY is required")
+	}
+	key, err := contentbundle.ParsePrivateKey(encoded)
+	if err != nil {
+		log.Fatalln(errors.Wrap(err, "CONTENT_BUNDLE_SIGNING_KEY"))
+	}
+	return key
+}
@@ -1,9 +1,17 @@
 package fstorage
 
-import "os"
+import (
+	"os"
+	"time"
+)
+
+const (
+	DefaultURLLifetime = time.Hour
+)
 
 type Config struct {
 	BasePath string
+	URL      *URLConfig
 }
 
 func getDefaultConfig() *Config {
@@ -12,3 +20,17 @@ func getDefaultConfig() *Config {
 		BasePath: path,
 	}
 }
+
+type S3Config struct {
+	// Endpoint is the host (and optionally the port) of the S3-compatible service, e.g. s3.eu-central-1.amazonaws.com or localhost:9002.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Prefix is prepended to the keys of all objects, so that the bucket can be shared with other apps.
+	Prefix string
+	// DisableSSL makes the storage connect via plain HTTP (e.g. to a local MinIO instance).
+	DisableSSL bool
+	URL        *URLConfig
+}
@@ -0,0 +1,13 @@
+package fstorage
+
+import (
+	"mime"
+	"path"
+)
+
+func contentTypeByFilename(filename string) string {
+	if contentType := mime.TypeByExtension(path.Ext(filename)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
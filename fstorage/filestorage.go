@@ -4,11 +4,14 @@ import (
 	"io"
 	"os"
 	"path"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
 type fileStorage struct {
+	*URLSigner
 	basePath string
 }
 
@@ -18,6 +21,7 @@ func New(cfg *Config) FileStorage {
 	}
 
 	return &fileStorage{
+		NewURLSigner(cfg.URL),
 		cfg.BasePath,
 	}
 }
@@ -36,6 +40,69 @@ func (storage *fileStorage) Put(file io.Reader, filename string) error {
 	return nil
 }
 
+func (storage *fileStorage) Get(filename string) (io.ReadCloser, error) {
+	fullPath := path.Join(storage.basePath, filename)
+	f, err := os.Open(fullPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't open a file")
+	}
+	return f, nil
+}
+
+func (storage *fileStorage) Stat(filename string) (*FileInfo, error) {
+	fi, err := os.Stat(path.Join(storage.basePath, filename))
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't stat a file")
+	}
+	if fi.IsDir() {
+		return nil, ErrNotExist
+	}
+	return newFileInfo(fi), nil
+}
+
+func (storage *fileStorage) Exists(filename string) (bool, error) {
+	_, err := storage.Stat(filename)
+	if err == ErrNotExist {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (storage *fileStorage) List(prefix string) ([]*FileInfo, error) {
+	entries, err := os.ReadDir(storage.basePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read the directory")
+	}
+	files := make([]*FileInfo, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		fi, err := entry.Info()
+		if os.IsNotExist(err) {
+			// removed in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't stat a file")
+		}
+		files = append(files, newFileInfo(fi))
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
 func (storage *fileStorage) Remove(filename string) error {
 	fullPath := path.Join(storage.basePath, filename)
 	err := os.Remove(fullPath)
@@ -44,3 +111,12 @@ func (storage *fileStorage) Remove(filename string) error {
 	}
 	return nil
 }
+
+func newFileInfo(fi os.FileInfo) *FileInfo {
+	return &FileInfo{
+		Name:        fi.Name(),
+		Size:        fi.Size(),
+		ModTime:     fi.ModTime(),
+		ContentType: contentTypeByFilename(fi.Name()),
+	}
+}
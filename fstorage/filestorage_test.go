@@ -0,0 +1,14 @@
+package fstorage_test
+
+import (
+	"testing"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage/fstoragetest"
+)
+
+func TestFileStorage(t *testing.T) {
+	fstoragetest.Run(t, fstorage.New(&fstorage.Config{
+		BasePath: t.TempDir(),
+	}))
+}
@@ -0,0 +1,215 @@
+// Package fstoragetest contains the conformance suite every fstorage.FileStorage implementation has to pass.
+//
+// A test of an implementation only has to create the storage and pass it to Run:
+//
+//	func TestS3Storage(t *testing.T) {
+//		storage, err := fstorage.NewS3Storage(&fstorage.S3Config{...})
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		fstoragetest.Run(t, storage)
+//	}
+package fstoragetest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage/fstorageutil"
+)
+
+// Run runs all the conformance tests against the storage, every test works on its own files and removes them afterwards.
+func Run(t *testing.T, storage fstorage.FileStorage) {
+	t.Helper()
+	for _, test := range [...]struct {
+		name string
+		fn   func(t *testing.T, storage fstorage.FileStorage)
+	}{
+		{"PutAndGet", testPutAndGet},
+		{"PutOverwrites", testPutOverwrites},
+		{"PutLargeFile", testPutLargeFile},
+		{"GetMissing", testGetMissing},
+		{"StatAndExists", testStatAndExists},
+		{"StatMissing", testStatMissing},
+		{"List", testList},
+		{"URL", testURL},
+		{"Remove", testRemove},
+		{"RemoveMissing", testRemoveMissing},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.fn(t, storage)
+		})
+	}
+}
+
+func testPutAndGet(t *testing.T, storage fstorage.FileStorage) {
+	filename := newFilename(t, storage)
+	content := []byte("zdam egzamin zawodowy")
+	put(t, storage, filename, content)
+	assertContent(t, storage, filename, content)
+}
+
+func testPutOverwrites(t *testing.T, storage fstorage.FileStorage) {
+	filename := newFilename(t, storage)
+	put(t, storage, filename, []byte("the first version, it's longer than the second one"))
+	content := []byte("the second version")
+	put(t, storage, filename, content)
+	assertContent(t, storage, filename, content)
+}
+
+// testPutLargeFile checks a file that doesn't fit in a single part of a multipart upload (5 MiB).
+func testPutLargeFile(t *testing.T, storage fstorage.FileStorage) {
+	filename := newFilename(t, storage)
+	content := make([]byte, 6<<20+123)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+	put(t, storage, filename, content)
+	assertContent(t, storage, filename, content)
+}
+
+func testGetMissing(t *testing.T, storage fstorage.FileStorage) {
+	f, err := storage.Get(fstorageutil.GenerateFilename("png"))
+	if err == nil {
+		_ = f.Close()
+	}
+	if err != fstorage.ErrNotExist {
+		t.Fatalf("Get should return fstorage.ErrNotExist when the file doesn't exist, got: %v", err)
+	}
+}
+
+func testStatAndExists(t *testing.T, storage fstorage.FileStorage) {
+	filename := newFilename(t, storage)
+	content := []byte("zdam egzamin zawodowy")
+	put(t, storage, filename, content)
+
+	fi, err := storage.Stat(filename)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	if fi.Name != filename || fi.Size != int64(len(content)) || fi.ModTime.IsZero() {
+		t.Fatalf("Stat returned unexpected info: %+v", fi)
+	}
+	if fi.ContentType != "image/png" {
+		t.Fatalf("expected the content type image/png, got %s", fi.ContentType)
+	}
+
+	exists, err := storage.Exists(filename)
+	if err != nil {
+		t.Fatalf("Exists: %v", err)
+	}
+	if !exists {
+		t.Fatal("Exists should return true for a stored file")
+	}
+}
+
+func testStatMissing(t *testing.T, storage fstorage.FileStorage) {
+	filename := fstorageutil.GenerateFilename("png")
+	if _, err := storage.Stat(filename); err != fstorage.ErrNotExist {
+		t.Fatalf("Stat should return fstorage.ErrNotExist when the file doesn't exist, got: %v", err)
+	}
+	exists, err := storage.Exists(filename)
+	if err != nil {
+		t.Fatalf("Exists: %v", err)
+	}
+	if exists {
+		t.Fatal("Exists should return false when the file doesn't exist")
+	}
+}
+
+func testList(t *testing.T, storage fstorage.FileStorage) {
+	// the filenames share a unique prefix, so that the files stored by the other tests aren't listed
+	prefix := strings.TrimSuffix(fstorageutil.GenerateFilename("png"), ".png")
+	filenames := []string{prefix + "-b.png", prefix + "-a.png", prefix + "-c.jpg"}
+	for _, filename := range filenames {
+		filename := filename
+		t.Cleanup(func() {
+			_ = storage.Remove(filename)
+		})
+		put(t, storage, filename, []byte(filename))
+	}
+
+	files, err := storage.List(prefix)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	expected := []string{prefix + "-a.png", prefix + "-b.png", prefix + "-c.jpg"}
+	if len(files) != len(expected) {
+		t.Fatalf("expected %d files, got %d", len(expected), len(files))
+	}
+	for i, fi := range files {
+		if fi.Name != expected[i] || fi.Size != int64(len(expected[i])) {
+			t.Fatalf("unexpected file at %d: %+v", i, fi)
+		}
+	}
+}
+
+func testURL(t *testing.T, storage fstorage.FileStorage) {
+	if u := storage.URL(""); u != "" {
+		t.Fatalf("URL should return an empty string for an empty filename, got %s", u)
+	}
+	filename := fstorageutil.GenerateFilename("png")
+	if u := storage.URL(filename); !strings.Contains(u, filename) {
+		t.Fatalf("URL should contain the filename, got %s", u)
+	}
+}
+
+func testRemove(t *testing.T, storage fstorage.FileStorage) {
+	filename := newFilename(t, storage)
+	put(t, storage, filename, []byte("to be removed"))
+	if err := storage.Remove(filename); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	f, err := storage.Get(filename)
+	if err == nil {
+		_ = f.Close()
+	}
+	if err != fstorage.ErrNotExist {
+		t.Fatalf("Get should return fstorage.ErrNotExist after the file has been removed, got: %v", err)
+	}
+}
+
+func testRemoveMissing(t *testing.T, storage fstorage.FileStorage) {
+	if err := storage.Remove(fstorageutil.GenerateFilename("png")); err != nil {
+		t.Fatalf("Remove should ignore the files that don't exist, got: %v", err)
+	}
+}
+
+// newFilename generates a unique filename and removes the file after the test.
+func newFilename(t *testing.T, storage fstorage.FileStorage) string {
+	t.Helper()
+	filename := fstorageutil.GenerateFilename("png")
+	t.Cleanup(func() {
+		_ = storage.Remove(filename)
+	})
+	return filename
+}
+
+func put(t *testing.T, storage fstorage.FileStorage, filename string, content []byte) {
+	t.Helper()
+	// the reader is wrapped, so that the implementations can't rely on the length of a bytes.Reader
+	if err := storage.Put(io.MultiReader(bytes.NewReader(content)), filename); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+}
+
+func assertContent(t *testing.T, storage fstorage.FileStorage, filename string, expected []byte) {
+	t.Helper()
+	f, err := storage.Get(filename)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer f.Close()
+	actual, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if !bytes.Equal(actual, expected) {
+		t.Fatalf("the content differs: expected %d bytes, got %d bytes", len(expected), len(actual))
+	}
+}
@@ -1,8 +1,36 @@
 package fstorage
 
-import "io"
+import (
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotExist is returned by the storages when the requested file doesn't exist.
+var ErrNotExist = errors.New("the file doesn't exist")
+
+type FileInfo struct {
+	Name        string
+	Size        int64
+	ModTime     time.Time
+	ContentType string
+}
 
 type FileStorage interface {
 	Put(file io.Reader, filename string) error
+	// Get opens the file, the caller is responsible for closing it.
+	Get(filename string) (io.ReadCloser, error)
+	Stat(filename string) (*FileInfo, error)
+	Exists(filename string) (bool, error)
+	// List returns the files whose names start with the given prefix, sorted by name.
+	List(prefix string) ([]*FileInfo, error)
 	Remove(filename string) error
+	// URL returns the absolute URL the file can be downloaded from, see URLConfig.
+	URL(filename string) string
+	// VerifyURL checks the signature of an URL returned by URL (its query string).
+	VerifyURL(filename string, query url.Values) error
+	// SigningEnabled reports whether the URLs are signed.
+	SigningEnabled() bool
 }
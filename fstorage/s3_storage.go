@@ -0,0 +1,171 @@
+package fstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+)
+
+// s3Storage keeps the files in a bucket of an S3-compatible object storage (AWS S3, MinIO, etc.).
+type s3Storage struct {
+	*URLSigner
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3Storage(cfg *S3Config) (FileStorage, error) {
+	if cfg == nil || cfg.Endpoint == "" {
+		return nil, errors.New("cfg.Endpoint is required")
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("cfg.Bucket is required")
+	}
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: !cfg.DisableSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create the s3 client")
+	}
+	exists, err := client.BucketExists(context.Background(), cfg.Bucket)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't check whether the bucket exists")
+	}
+	if !exists {
+		return nil, errors.Errorf("the bucket '%s' doesn't exist", cfg.Bucket)
+	}
+	return &s3Storage{
+		URLSigner: NewURLSigner(cfg.URL),
+		client:    client,
+		bucket:    cfg.Bucket,
+		prefix:    strings.Trim(cfg.Prefix, "/"),
+	}, nil
+}
+
+func (storage *s3Storage) Put(file io.Reader, filename string) error {
+	// the files smaller than a single part (most of the images) are uploaded in one request with the known size,
+	// the bigger ones are uploaded in parts
+	head, err := io.ReadAll(io.LimitReader(file, minPartSize+1))
+	if err != nil {
+		return errors.Wrap(err, "couldn't read a file")
+	}
+	var body io.Reader = bytes.NewReader(head)
+	size := int64(len(head))
+	if size > minPartSize {
+		body = io.MultiReader(body, file)
+		size = -1
+	}
+	_, err = storage.client.PutObject(context.Background(), storage.bucket, storage.key(filename), body, size, minio.PutObjectOptions{
+		ContentType: contentTypeByFilename(filename),
+		PartSize:    minPartSize,
+	})
+	if err != nil {
+		return errors.Wrap(err, "couldn't upload a file")
+	}
+	return nil
+}
+
+func (storage *s3Storage) Get(filename string) (io.ReadCloser, error) {
+	obj, err := storage.client.GetObject(context.Background(), storage.bucket, storage.key(filename), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't open a file")
+	}
+	// GetObject doesn't send any request until the object is read, Stat reports whether the object exists
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if isNotFoundError(err) {
+			return nil, ErrNotExist
+		}
+		return nil, errors.Wrap(err, "couldn't open a file")
+	}
+	return obj, nil
+}
+
+func (storage *s3Storage) Stat(filename string) (*FileInfo, error) {
+	info, err := storage.client.StatObject(context.Background(), storage.bucket, storage.key(filename), minio.StatObjectOptions{})
+	if isNotFoundError(err) {
+		return nil, ErrNotExist
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't stat a file")
+	}
+	return storage.newFileInfo(info), nil
+}
+
+func (storage *s3Storage) Exists(filename string) (bool, error) {
+	_, err := storage.Stat(filename)
+	if err == ErrNotExist {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (storage *s3Storage) List(prefix string) ([]*FileInfo, error) {
+	files := make([]*FileInfo, 0)
+	// the objects are listed in the lexicographical order of their keys
+	for info := range storage.client.ListObjects(context.Background(), storage.bucket, minio.ListObjectsOptions{
+		Prefix:    storage.key(prefix),
+		Recursive: true,
+	}) {
+		if info.Err != nil {
+			return nil, errors.Wrap(info.Err, "couldn't list the files")
+		}
+		files = append(files, storage.newFileInfo(info))
+	}
+	return files, nil
+}
+
+func (storage *s3Storage) Remove(filename string) error {
+	// removing an object that doesn't exist isn't an error
+	err := storage.client.RemoveObject(context.Background(), storage.bucket, storage.key(filename), minio.RemoveObjectOptions{})
+	if err != nil {
+		return errors.Wrap(err, "couldn't remove a file")
+	}
+	return nil
+}
+
+func (storage *s3Storage) key(filename string) string {
+	if storage.prefix == "" {
+		return filename
+	}
+	return storage.prefix + "/" + filename
+}
+
+func (storage *s3Storage) newFileInfo(info minio.ObjectInfo) *FileInfo {
+	name := info.Key
+	if storage.prefix != "" {
+		name = strings.TrimPrefix(name, storage.prefix+"/")
+	}
+	contentType := info.ContentType
+	if contentType == "" {
+		// ListObjects doesn't return the content type
+		contentType = contentTypeByFilename(name)
+	}
+	return &FileInfo{
+		Name:        name,
+		Size:        info.Size,
+		ModTime:     info.LastModified,
+		ContentType: contentType,
+	}
+}
+
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NotFound"
+}
+
+// minPartSize is the smallest part S3 accepts, it limits the memory needed to upload a file of an unknown size.
+const minPartSize = 5 << 20
@@ -0,0 +1,5 @@
+// Package s3test runs the storage conformance suite against S3Storage and an in-process S3 fake (gofakes3).
+// It's a separate module, so that the fake and its dependencies aren't required by the server.
+//
+// Run the tests from this directory: go test ./...
+package s3test
@@ -0,0 +1,53 @@
+package s3test_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage/fstoragetest"
+)
+
+const testBucket = "questions"
+
+func TestS3Storage(t *testing.T) {
+	backend := s3mem.New()
+	if err := backend.CreateBucket(testBucket); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(gofakes3.New(backend).Server())
+	defer srv.Close()
+
+	storage, err := fstorage.NewS3Storage(&fstorage.S3Config{
+		Endpoint:        strings.TrimPrefix(srv.URL, "http://"),
+		Region:          "us-east-1",
+		Bucket:          testBucket,
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+		Prefix:          "images",
+		DisableSSL:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fstoragetest.Run(t, storage)
+}
+
+func TestNewS3StorageMissingBucket(t *testing.T) {
+	srv := httptest.NewServer(gofakes3.New(s3mem.New()).Server())
+	defer srv.Close()
+
+	_, err := fstorage.NewS3Storage(&fstorage.S3Config{
+		Endpoint:   strings.TrimPrefix(srv.URL, "http://"),
+		Region:     "us-east-1",
+		Bucket:     testBucket,
+		DisableSSL: true,
+	})
+	if err == nil {
+		t.Fatal("NewS3Storage should fail when the bucket doesn't exist")
+	}
+}
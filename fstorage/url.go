@@ -0,0 +1,103 @@
+package fstorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	expiresQueryParam   = "expires"
+	signatureQueryParam = "signature"
+)
+
+var (
+	ErrMissingSignature = errors.New("the url isn't signed")
+	ErrInvalidSignature = errors.New("the url signature is invalid")
+	ErrURLExpired       = errors.New("the url has expired")
+)
+
+type URLConfig struct {
+	// BaseURL is the address the files are served from (a CDN, a bucket or the image route of the server).
+	// If it's empty, URL returns bare filenames.
+	BaseURL string
+	// SigningKey enables the signed URLs, they're valid for at least Lifetime.
+	SigningKey []byte
+	Lifetime   time.Duration
+}
+
+// URLSigner builds the URLs of the files and verifies their signatures.
+type URLSigner struct {
+	baseURL    string
+	signingKey []byte
+	lifetime   time.Duration
+}
+
+func NewURLSigner(cfg *URLConfig) *URLSigner {
+	if cfg == nil {
+		return &URLSigner{}
+	}
+	s := &URLSigner{
+		baseURL:    strings.TrimSuffix(cfg.BaseURL, "/"),
+		signingKey: cfg.SigningKey,
+		lifetime:   cfg.Lifetime,
+	}
+	if len(s.signingKey) > 0 && s.lifetime <= 0 {
+		s.lifetime = DefaultURLLifetime
+	}
+	return s
+}
+
+func (s *URLSigner) URL(filename string) string {
+	if filename == "" || s.baseURL == "" {
+		return filename
+	}
+	u := s.baseURL + "/" + url.PathEscape(filename)
+	if !s.SigningEnabled() {
+		return u
+	}
+	// the expiry time is rounded, so that the URL of a file doesn't change with every request and can be cached
+	expires := time.Now().Truncate(s.lifetime).Add(2 * s.lifetime).Unix()
+	return u + "?" + url.Values{
+		expiresQueryParam:   []string{strconv.FormatInt(expires, 10)},
+		signatureQueryParam: []string{s.sign(filename, expires)},
+	}.Encode()
+}
+
+func (s *URLSigner) SigningEnabled() bool {
+	return len(s.signingKey) > 0
+}
+
+// VerifyURL checks the signature passed in the query string of the file URL, it accepts any URL if signing is disabled.
+func (s *URLSigner) VerifyURL(filename string, query url.Values) error {
+	if !s.SigningEnabled() {
+		return nil
+	}
+	signature := query.Get(signatureQueryParam)
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	expires, err := strconv.ParseInt(query.Get(expiresQueryParam), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(filename, expires))) {
+		return ErrInvalidSignature
+	}
+	if time.Now().Unix() > expires {
+		return ErrURLExpired
+	}
+	return nil
+}
+
+func (s *URLSigner) sign(filename string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(filename + ":" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
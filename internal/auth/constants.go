@@ -0,0 +1,7 @@
+package auth
+
+import "time"
+
+const (
+	DefaultAccessTokenLifetime = 15 * time.Minute
+)
@@ -7,21 +7,15 @@ import (
 	"github.com/pkg/errors"
 )
 
-type Credentials struct {
-	Email    string
-	Password string
-}
-
 type Metadata struct {
-	StaySignedIn bool
-	Credentials  Credentials
+	SessionID string
+	ExpiresAt time.Time
 }
 
 func (opts Metadata) ToMapClaims() jwt.MapClaims {
 	mClaims := jwt.MapClaims{}
-	mClaims["email"] = opts.Credentials.Email
-	mClaims["password"] = opts.Credentials.Password
-	mClaims["stay_signed_in"] = opts.StaySignedIn
+	mClaims["session_id"] = opts.SessionID
+	mClaims["exp"] = opts.ExpiresAt.Unix()
 	return mClaims
 }
 
@@ -36,12 +30,7 @@ func NewTokenGenerator(accessSecret string) *TokenGenerator {
 }
 
 func (g *TokenGenerator) Generate(metadata Metadata) (string, error) {
-	atClaims := metadata.ToMapClaims()
-	if !metadata.StaySignedIn {
-		atClaims["exp"] = time.Now().Add(time.Hour * 24).Unix()
-	}
-
-	at := jwt.NewWithClaims(jwt.SigningMethodHS256, atClaims)
+	at := jwt.NewWithClaims(jwt.SigningMethodHS256, metadata.ToMapClaims())
 	accessToken, err := at.SignedString([]byte(g.accessSecret))
 	if err != nil {
 		return "", errors.Wrap(err, "couldn't get signed access token")
@@ -84,24 +73,17 @@ func extractTokenMetadata(secret, tokenString string) (*Metadata, error) {
 		return nil, errors.New("couldn't extract token metadata")
 	}
 
-	staySignedIn, ok := claims["stay_signed_in"].(bool)
-	if !ok {
-		return nil, errors.New("invalid token payload (staySignedIn should be a boolean)")
-	}
-	email, ok := claims["email"].(string)
-	if !ok {
-		return nil, errors.New("invalid token payload (email should be a string)")
+	sessionID, ok := claims["session_id"].(string)
+	if !ok || sessionID == "" {
+		return nil, errors.New("invalid token payload (sessionID should be a non-empty string)")
 	}
-	password, ok := claims["password"].(string)
+	exp, ok := claims["exp"].(float64)
 	if !ok {
-		return nil, errors.New("invalid token payload (password should be a string)")
+		return nil, errors.New("invalid token payload (exp should be a number)")
 	}
 
 	return &Metadata{
-		StaySignedIn: staySignedIn,
-		Credentials: Credentials{
-			Email:    email,
-			Password: password,
-		},
+		SessionID: sessionID,
+		ExpiresAt: time.Unix(int64(exp), 0),
 	}, nil
 }
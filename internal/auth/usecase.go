@@ -2,11 +2,30 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 )
 
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
 type Usecase interface {
-	SignIn(ctx context.Context, email, password string, staySignedIn bool) (*model.User, string, error)
-	ExtractAccessTokenMetadata(ctx context.Context, accessToken string) (*model.User, error)
+	SignUp(ctx context.Context, input *model.UserInput) (*model.User, error)
+	ActivateAccount(ctx context.Context, token string) (*model.User, error)
+	ResendActivationEmail(ctx context.Context, email string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SignIn(ctx context.Context, email, password string, staySignedIn bool, client ClientInfo) (*model.User, *TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*model.User, *TokenPair, error)
+	ExtractAccessTokenMetadata(ctx context.Context, accessToken string) (*model.Session, error)
 }
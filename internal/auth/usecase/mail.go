@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"net/url"
+)
+
+const (
+	activationEmailSubject = "Aktywacja konta"
+	activationEmailText    = `Cześć %s,
+
+dziękujemy za rejestrację. Aby aktywować konto, otwórz poniższy link:
+%s
+
+Link jest ważny przez %d godz. Jeśli to nie Ty zakładałeś konto, zignoruj tę wiadomość.
+`
+	passwordResetEmailSubject = "Resetowanie hasła"
+	passwordResetEmailText    = `Cześć %s,
+
+otrzymaliśmy prośbę o zresetowanie hasła do Twojego konta. Aby ustawić nowe hasło, otwórz poniższy link:
+%s
+
+Link jest ważny przez %d min. i może zostać użyty tylko raz. Jeśli to nie Ty prosiłeś o zmianę hasła, zignoruj tę wiadomość.
+`
+)
+
+// buildURLWithToken adds the token as the "token" query parameter to the given URL.
+func buildURLWithToken(baseURL, token string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
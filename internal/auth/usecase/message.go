@@ -1,6 +1,14 @@
 package usecase
 
 const (
-	messageInvalidCredentials = "Niepoprawny email/hasło."
-	messageInvalidAccessToken = "Niepoprawny token."
+	messageInvalidCredentials     = "Niepoprawny email/hasło."
+	messageInvalidAccessToken     = "Niepoprawny token."
+	messageInvalidRefreshToken    = "Niepoprawny token odświeżający."
+	messageRefreshTokenReused     = "Token odświeżający został już wykorzystany. Ze względów bezpieczeństwa sesja została zakończona."
+	messageSessionExpired         = "Sesja wygasła. Zaloguj się ponownie."
+	messageFailedToSignIn         = "Wystąpił błąd podczas logowania."
+	messageAccountNotActivated    = "Konto nie zostało jeszcze aktywowane. Sprawdź swoją skrzynkę e-mail."
+	messageInvalidActivationToken = "Link aktywacyjny jest nieprawidłowy lub wygasł."
+	messageFailedToSendEmail      = "Wystąpił błąd podczas wysyłania wiadomości e-mail."
+	messageInvalidResetToken      = "Link do resetowania hasła jest nieprawidłowy lub wygasł."
 )
@@ -2,23 +2,60 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/mailer"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/auth"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/auth/jwt"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/session"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/user"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/usertoken"
 	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+	"github.com/zdam-egzamin-zawodowy/backend/util/tokenutil"
 )
 
+var log = logrus.WithField("package", "internal/auth/usecase")
+
 type Config struct {
-	UserRepository user.Repository
-	TokenGenerator *jwt.TokenGenerator
+	UserRepository      user.Repository
+	UserUsecase         user.Usecase
+	SessionRepository   session.Repository
+	UserTokenRepository usertoken.Repository
+	LoginLimiterUsecase loginlimiter.Usecase
+	TokenGenerator      *jwt.TokenGenerator
+	Mailer              mailer.Mailer
+	// AccountActivationURL is the frontend page the activation token is appended to (as the "token" query parameter).
+	AccountActivationURL string
+	// PasswordResetURL is the frontend page the password reset token is appended to (as the "token" query parameter).
+	PasswordResetURL string
+	// AccessTokenLifetime defaults to auth.DefaultAccessTokenLifetime.
+	AccessTokenLifetime time.Duration
+	// RefreshTokenLifetime defaults to session.DefaultLifetime.
+	RefreshTokenLifetime time.Duration
+	// StaySignedInRefreshTokenLifetime defaults to session.StaySignedInLifetime.
+	StaySignedInRefreshTokenLifetime time.Duration
 }
 
 type Usecase struct {
-	userRepository user.Repository
-	tokenGenerator *jwt.TokenGenerator
+	userRepository                   user.Repository
+	userUsecase                      user.Usecase
+	sessionRepository                session.Repository
+	userTokenRepository              usertoken.Repository
+	loginLimiterUsecase              loginlimiter.Usecase
+	tokenGenerator                   *jwt.TokenGenerator
+	mailer                           mailer.Mailer
+	accountActivationURL             string
+	passwordResetURL                 string
+	accessTokenLifetime              time.Duration
+	refreshTokenLifetime             time.Duration
+	staySignedInRefreshTokenLifetime time.Duration
 }
 
 var _ auth.Usecase = &Usecase{}
@@ -27,39 +64,291 @@ func New(cfg *Config) (*Usecase, error) {
 	if cfg == nil || cfg.UserRepository == nil {
 		return nil, errors.New("cfg.UserRepository is required")
 	}
-	return &Usecase{
-		cfg.UserRepository,
-		cfg.TokenGenerator,
-	}, nil
+	if cfg.UserUsecase == nil {
+		return nil, errors.New("cfg.UserUsecase is required")
+	}
+	if cfg.SessionRepository == nil {
+		return nil, errors.New("cfg.SessionRepository is required")
+	}
+	if cfg.UserTokenRepository == nil {
+		return nil, errors.New("cfg.UserTokenRepository is required")
+	}
+	if cfg.LoginLimiterUsecase == nil {
+		return nil, errors.New("cfg.LoginLimiterUsecase is required")
+	}
+	if cfg.TokenGenerator == nil {
+		return nil, errors.New("cfg.TokenGenerator is required")
+	}
+	if cfg.Mailer == nil {
+		return nil, errors.New("cfg.Mailer is required")
+	}
+	ucase := &Usecase{
+		userRepository:                   cfg.UserRepository,
+		userUsecase:                      cfg.UserUsecase,
+		sessionRepository:                cfg.SessionRepository,
+		userTokenRepository:              cfg.UserTokenRepository,
+		loginLimiterUsecase:              cfg.LoginLimiterUsecase,
+		tokenGenerator:                   cfg.TokenGenerator,
+		mailer:                           cfg.Mailer,
+		accountActivationURL:             cfg.AccountActivationURL,
+		passwordResetURL:                 cfg.PasswordResetURL,
+		accessTokenLifetime:              cfg.AccessTokenLifetime,
+		refreshTokenLifetime:             cfg.RefreshTokenLifetime,
+		staySignedInRefreshTokenLifetime: cfg.StaySignedInRefreshTokenLifetime,
+	}
+	if ucase.accessTokenLifetime <= 0 {
+		ucase.accessTokenLifetime = auth.DefaultAccessTokenLifetime
+	}
+	if ucase.refreshTokenLifetime <= 0 {
+		ucase.refreshTokenLifetime = session.DefaultLifetime
+	}
+	if ucase.staySignedInRefreshTokenLifetime <= 0 {
+		ucase.staySignedInRefreshTokenLifetime = session.StaySignedInLifetime
+	}
+	return ucase, nil
+}
+
+func (ucase *Usecase) SignUp(ctx context.Context, input *model.UserInput) (*model.User, error) {
+	role := model.RoleUser
+	activated := false
+	u, err := ucase.userUsecase.Store(ctx, &model.UserInput{
+		DisplayName: input.DisplayName,
+		Password:    input.Password,
+		Email:       input.Email,
+		Role:        &role,
+		Activated:   &activated,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ucase.sendActivationEmail(ctx, u); err != nil {
+		// the account is removed (even if the request has been cancelled), so that the user can sign up again with the same e-mail
+		if _, deleteErr := ucase.userRepository.Delete(context.Background(), &model.UserFilter{
+			ID: []int{u.ID},
+		}); deleteErr != nil {
+			log.WithError(deleteErr).WithField("userID", u.ID).Error("Couldn't delete the account after a failed sign-up")
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (ucase *Usecase) ActivateAccount(ctx context.Context, token string) (*model.User, error) {
+	t, err := ucase.userTokenRepository.GetByHash(ctx, model.UserTokenTypeActivation, tokenutil.Hash(token))
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.User == nil || t.IsUsed() || t.IsExpired() {
+		return nil, errors.New(messageInvalidActivationToken)
+	}
+	marked, err := ucase.userTokenRepository.MarkAsUsed(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, errors.New(messageInvalidActivationToken)
+	}
+
+	activated := true
+	return ucase.userUsecase.UpdateOneByID(ctx, t.UserID, &model.UserInput{
+		Activated: &activated,
+	})
 }
 
-func (ucase *Usecase) SignIn(ctx context.Context, email, password string, staySignedIn bool) (*model.User, string, error) {
+// ResendActivationEmail doesn't return an error if there is no inactive account with the given e-mail,
+// so it cannot be used to check whether an e-mail is registered.
+func (ucase *Usecase) ResendActivationEmail(ctx context.Context, email string) error {
+	activated := false
+	users, _, err := ucase.userRepository.Fetch(ctx, &user.FetchConfig{
+		Limit: 1,
+		Count: false,
+		Filter: &model.UserFilter{
+			Email:     []string{strings.ToLower(strings.TrimSpace(email))},
+			Activated: &activated,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	// the e-mail is sent in the background, so that the response time doesn't reveal whether the account exists
+	go func(u *model.User) {
+		if err := ucase.sendActivationEmail(context.Background(), u); err != nil {
+			log.WithError(err).WithField("userID", u.ID).Warn("Couldn't resend the activation e-mail")
+		}
+	}(users[0])
+
+	return nil
+}
+
+// RequestPasswordReset doesn't return an error if there is no account with the given e-mail,
+// so it cannot be used to check whether an e-mail is registered.
+func (ucase *Usecase) RequestPasswordReset(ctx context.Context, email string) error {
+	users, _, err := ucase.userRepository.Fetch(ctx, &user.FetchConfig{
+		Limit: 1,
+		Count: false,
+		Filter: &model.UserFilter{
+			Email: []string{strings.ToLower(strings.TrimSpace(email))},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	// the e-mail is sent in the background, so that the response time doesn't reveal whether the account exists
+	go func(u *model.User) {
+		if err := ucase.sendPasswordResetEmail(context.Background(), u); err != nil {
+			log.WithError(err).WithField("userID", u.ID).Warn("Couldn't send the password reset e-mail")
+		}
+	}(users[0])
+
+	return nil
+}
+
+func (ucase *Usecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	input := &model.UserInput{
+		Password: &newPassword,
+	}
+	if err := ucase.userUsecase.ValidateInput(input); err != nil {
+		return err
+	}
+
+	t, err := ucase.userTokenRepository.GetByHash(ctx, model.UserTokenTypePasswordReset, tokenutil.Hash(token))
+	if err != nil {
+		return err
+	}
+	if t == nil || t.User == nil || t.IsUsed() || t.IsExpired() {
+		return errors.New(messageInvalidResetToken)
+	}
+	redeemed, err := ucase.userTokenRepository.Redeem(ctx, t.ID, input)
+	if err != nil {
+		return err
+	}
+	if !redeemed {
+		return errors.New(messageInvalidResetToken)
+	}
+
+	unused := false
+	if _, err := ucase.userTokenRepository.Delete(ctx, &model.UserTokenFilter{
+		UserID: []int{t.UserID},
+		Type:   []model.UserTokenType{model.UserTokenTypePasswordReset},
+		Used:   &unused,
+	}); err != nil {
+		return err
+	}
+	if _, err := ucase.sessionRepository.Delete(ctx, &model.SessionFilter{
+		UserID: []int{t.UserID},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ucase *Usecase) SignIn(
+	ctx context.Context,
+	email, password string,
+	staySignedIn bool,
+	client auth.ClientInfo,
+) (*model.User, *auth.TokenPair, error) {
+	if err := ucase.loginLimiterUsecase.Check(ctx, email, client.IP); err != nil {
+		return nil, nil, err
+	}
 	u, err := ucase.GetUserByCredentials(ctx, email, password)
 	if err != nil {
-		return nil, "", err
+		if limiterErr := ucase.loginLimiterUsecase.RegisterFailure(ctx, email, client.IP); limiterErr != nil {
+			log.WithError(limiterErr).Warn("Couldn't register the failed sign-in attempt")
+		}
+		return nil, nil, err
+	}
+	if err := ucase.loginLimiterUsecase.RegisterSuccess(ctx, email); err != nil {
+		log.WithError(err).Warn("Couldn't reset the failed sign-in attempts")
+	}
+	if !u.IsActivated() {
+		return nil, nil, errors.New(messageAccountNotActivated)
 	}
 
-	token, err := ucase.tokenGenerator.Generate(jwt.Metadata{
+	sess, err := ucase.sessionRepository.Store(ctx, &model.SessionInput{
+		UserID:       u.ID,
+		UserAgent:    client.UserAgent,
+		IP:           client.IP,
 		StaySignedIn: staySignedIn,
-		Credentials: jwt.Credentials{
-			Email:    u.Email,
-			Password: u.Password,
-		},
+		ExpiresAt:    time.Now().Add(ucase.getRefreshTokenLifetime(staySignedIn)),
 	})
 	if err != nil {
-		return nil, "", errorutil.Wrap(err, messageInvalidCredentials)
+		return nil, nil, err
+	}
+
+	tokens, err := ucase.generateTokenPair(ctx, sess)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return u, tokens, nil
+}
+
+func (ucase *Usecase) RefreshToken(ctx context.Context, refreshToken string) (*model.User, *auth.TokenPair, error) {
+	rt, err := ucase.sessionRepository.GetRefreshTokenByHash(ctx, tokenutil.Hash(refreshToken))
+	if err != nil {
+		return nil, nil, err
+	}
+	if rt == nil || rt.IsExpired() {
+		return nil, nil, errors.New(messageInvalidRefreshToken)
+	}
+	if rt.IsUsed() {
+		return nil, nil, ucase.revokeTokenFamily(ctx, rt)
+	}
+	marked, err := ucase.sessionRepository.MarkRefreshTokenAsUsed(ctx, rt.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !marked {
+		return nil, nil, ucase.revokeTokenFamily(ctx, rt)
+	}
+
+	sess, err := ucase.getSessionByID(ctx, rt.SessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	sess.ExpiresAt = time.Now().Add(ucase.getRefreshTokenLifetime(sess.StaySignedIn))
+	if err := ucase.sessionRepository.Prolong(ctx, sess.ID, sess.ExpiresAt); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := ucase.generateTokenPair(ctx, sess)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return u, token, nil
+	return sess.User, tokens, nil
 }
 
-func (ucase *Usecase) ExtractAccessTokenMetadata(ctx context.Context, accessToken string) (*model.User, error) {
+func (ucase *Usecase) ExtractAccessTokenMetadata(ctx context.Context, accessToken string) (*model.Session, error) {
 	metadata, err := ucase.tokenGenerator.ExtractAccessTokenMetadata(accessToken)
 	if err != nil {
 		return nil, errorutil.Wrap(err, messageInvalidAccessToken)
 	}
 
-	return ucase.GetUserByCredentials(ctx, metadata.Credentials.Email, metadata.Credentials.Password)
+	sess, err := ucase.getSessionByID(ctx, metadata.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if now := time.Now(); now.Sub(sess.LastSeenAt) >= session.LastSeenUpdateInterval {
+		if err := ucase.sessionRepository.UpdateLastSeenAt(ctx, sess.ID, now); err == nil {
+			sess.LastSeenAt = now
+		}
+	}
+
+	return sess, nil
 }
 
 func (ucase *Usecase) GetUserByCredentials(ctx context.Context, email, password string) (*model.User, error) {
@@ -84,3 +373,155 @@ func (ucase *Usecase) GetUserByCredentials(ctx context.Context, email, password
 
 	return u, nil
 }
+
+func (ucase *Usecase) getSessionByID(ctx context.Context, id string) (*model.Session, error) {
+	sessions, _, err := ucase.sessionRepository.Fetch(ctx, &session.FetchConfig{
+		Limit:         1,
+		Count:         false,
+		WithRelations: true,
+		Filter: &model.SessionFilter{
+			ID: []string{id},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 || sessions[0].User == nil {
+		return nil, errors.New(messageInvalidAccessToken)
+	}
+	if sessions[0].IsExpired() {
+		return nil, errors.New(messageSessionExpired)
+	}
+	if !sessions[0].User.IsActivated() {
+		return nil, errors.New(messageAccountNotActivated)
+	}
+	return sessions[0], nil
+}
+
+func (ucase *Usecase) sendActivationEmail(ctx context.Context, u *model.User) error {
+	token, err := ucase.generateUserToken(ctx, u, model.UserTokenTypeActivation, usertoken.ActivationTokenLifetime)
+	if err != nil {
+		return err
+	}
+
+	if err := ucase.mailer.Send(ctx, &mailer.Message{
+		To:      []string{u.Email},
+		Subject: activationEmailSubject,
+		Text: fmt.Sprintf(
+			activationEmailText,
+			u.DisplayName,
+			buildURLWithToken(ucase.accountActivationURL, token),
+			int(usertoken.ActivationTokenLifetime.Hours()),
+		),
+	}); err != nil {
+		log.WithError(err).WithField("userID", u.ID).Warn("Couldn't send the activation e-mail")
+		return errorutil.Wrap(err, messageFailedToSendEmail)
+	}
+
+	return nil
+}
+
+func (ucase *Usecase) sendPasswordResetEmail(ctx context.Context, u *model.User) error {
+	token, err := ucase.generateUserToken(ctx, u, model.UserTokenTypePasswordReset, usertoken.PasswordResetTokenLifetime)
+	if err != nil {
+		return err
+	}
+
+	if err := ucase.mailer.Send(ctx, &mailer.Message{
+		To:      []string{u.Email},
+		Subject: passwordResetEmailSubject,
+		Text: fmt.Sprintf(
+			passwordResetEmailText,
+			u.DisplayName,
+			buildURLWithToken(ucase.passwordResetURL, token),
+			int(usertoken.PasswordResetTokenLifetime.Minutes()),
+		),
+	}); err != nil {
+		return errorutil.Wrap(err, messageFailedToSendEmail)
+	}
+
+	return nil
+}
+
+// generateUserToken invalidates all unused tokens of the given type and returns a new one.
+func (ucase *Usecase) generateUserToken(
+	ctx context.Context,
+	u *model.User,
+	t model.UserTokenType,
+	lifetime time.Duration,
+) (string, error) {
+	unused := false
+	if _, err := ucase.userTokenRepository.Delete(ctx, &model.UserTokenFilter{
+		UserID: []int{u.ID},
+		Type:   []model.UserTokenType{t},
+		Used:   &unused,
+	}); err != nil {
+		return "", err
+	}
+
+	token, err := tokenutil.Generate(tokenutil.DefaultLength)
+	if err != nil {
+		return "", errorutil.Wrap(err, messageFailedToSendEmail)
+	}
+	if _, err := ucase.userTokenRepository.Store(ctx, &model.UserTokenInput{
+		UserID:    u.ID,
+		Type:      t,
+		TokenHash: tokenutil.Hash(token),
+		ExpiresAt: time.Now().Add(lifetime),
+	}); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (ucase *Usecase) generateTokenPair(ctx context.Context, sess *model.Session) (*auth.TokenPair, error) {
+	accessTokenExpiresAt := time.Now().Add(ucase.accessTokenLifetime)
+	if accessTokenExpiresAt.After(sess.ExpiresAt) {
+		accessTokenExpiresAt = sess.ExpiresAt
+	}
+	accessToken, err := ucase.tokenGenerator.Generate(jwt.Metadata{
+		SessionID: sess.ID,
+		ExpiresAt: accessTokenExpiresAt,
+	})
+	if err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSignIn)
+	}
+
+	refreshToken, err := tokenutil.Generate(tokenutil.DefaultLength)
+	if err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSignIn)
+	}
+	if _, err := ucase.sessionRepository.StoreRefreshToken(ctx, &model.RefreshTokenInput{
+		SessionID: sess.ID,
+		TokenHash: tokenutil.Hash(refreshToken),
+		ExpiresAt: sess.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &auth.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: sess.ExpiresAt,
+	}, nil
+}
+
+// revokeTokenFamily is called when an already used refresh token is presented again.
+// All refresh tokens issued for a session form one family, so the whole session gets deleted.
+func (ucase *Usecase) revokeTokenFamily(ctx context.Context, rt *model.RefreshToken) error {
+	if _, err := ucase.sessionRepository.Delete(ctx, &model.SessionFilter{
+		ID: []string{rt.SessionID},
+	}); err != nil {
+		return err
+	}
+	return errors.New(messageRefreshTokenReused)
+}
+
+func (ucase *Usecase) getRefreshTokenLifetime(staySignedIn bool) time.Duration {
+	if staySignedIn {
+		return ucase.staySignedInRefreshTokenLifetime
+	}
+	return ucase.refreshTokenLifetime
+}
@@ -15,6 +15,7 @@ const (
 
 var (
 	authenticateKey contextKey = "current_user"
+	sessionKey      contextKey = "current_session"
 )
 
 func Authenticate(ucase auth.Usecase) func(next http.Handler) http.Handler {
@@ -23,9 +24,10 @@ func Authenticate(ucase auth.Usecase) func(next http.Handler) http.Handler {
 			token := extractToken(r.Header.Get("Authorization"))
 			if token != "" {
 				ctx := r.Context()
-				user, err := ucase.ExtractAccessTokenMetadata(ctx, token)
-				if err == nil && user != nil {
-					ctx = context.WithValue(ctx, authenticateKey, user)
+				sess, err := ucase.ExtractAccessTokenMetadata(ctx, token)
+				if err == nil && sess != nil && sess.User != nil {
+					ctx = context.WithValue(ctx, authenticateKey, sess.User)
+					ctx = context.WithValue(ctx, sessionKey, sess)
 					r = r.WithContext(ctx)
 				}
 			}
@@ -48,3 +50,18 @@ func UserFromContext(ctx context.Context) (*model.User, error) {
 	}
 	return u, nil
 }
+
+func SessionFromContext(ctx context.Context) (*model.Session, error) {
+	sess := ctx.Value(sessionKey)
+	if sess == nil {
+		err := errors.New("couldn't retrieve *model.Session")
+		return nil, err
+	}
+
+	s, ok := sess.(*model.Session)
+	if !ok {
+		err := errors.New("*model.Session has wrong type")
+		return nil, err
+	}
+	return s, nil
+}
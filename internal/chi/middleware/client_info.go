@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"net"
+	"net/http"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/auth"
+)
+
+var (
+	clientInfoKey contextKey = "client_info"
+)
+
+// ClientInfoToContext should be used after chimiddleware.RealIP, so that r.RemoteAddr contains the real client IP.
+func ClientInfoToContext() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), clientInfoKey, auth.ClientInfo{
+				UserAgent: r.UserAgent(),
+				IP:        extractIP(r.RemoteAddr),
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func ClientInfoFromContext(ctx context.Context) (auth.ClientInfo, error) {
+	clientInfo := ctx.Value(clientInfoKey)
+	if clientInfo == nil {
+		err := errors.New("couldn't retrieve auth.ClientInfo")
+		return auth.ClientInfo{}, err
+	}
+
+	ci, ok := clientInfo.(auth.ClientInfo)
+	if !ok {
+		err := errors.New("auth.ClientInfo has wrong type")
+		return auth.ClientInfo{}, err
+	}
+	return ci, nil
+}
+
+func extractIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,283 @@
+// Package contentbundle builds signed zip bundles with all questions of a qualification,
+// so that they can be studied without network access.
+//
+// A bundle consists of:
+//   - questions.json - an array of questions along with the answer key, the image fields hold paths relative to the images directory,
+//   - images/ - the images of the questions and their downscaled variants,
+//   - manifest.json - the version of the bank, the IDs of the deleted questions (delta bundles only)
+//     and the SHA-256 hashes of the files above,
+//   - manifest.sig - the base64-encoded ed25519 signature of manifest.json.
+package contentbundle
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"io"
+	"math"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+)
+
+var log = logrus.WithField("package", "internal/contentbundle")
+
+type Config struct {
+	QuestionUsecase question.Usecase
+	FileStorage     fstorage.FileStorage
+	PrivateKey      ed25519.PrivateKey
+}
+
+type Builder struct {
+	questionUsecase question.Usecase
+	fileStorage     fstorage.FileStorage
+	privateKey      ed25519.PrivateKey
+}
+
+func NewBuilder(cfg *Config) (*Builder, error) {
+	if cfg == nil || cfg.QuestionUsecase == nil {
+		return nil, errors.New("cfg.QuestionUsecase is required")
+	}
+	if cfg.FileStorage == nil {
+		return nil, errors.New("cfg.FileStorage is required")
+	}
+	if len(cfg.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("cfg.PrivateKey is required")
+	}
+	return &Builder{
+		cfg.QuestionUsecase,
+		cfg.FileStorage,
+		cfg.PrivateKey,
+	}, nil
+}
+
+// PublicKey returns the key the bundles can be verified with.
+func (b *Builder) PublicKey() ed25519.PublicKey {
+	return b.privateKey.Public().(ed25519.PublicKey)
+}
+
+type WriteConfig struct {
+	Qualification *model.Qualification
+	// Since limits the bundle to the questions changed after the given version (a delta bundle).
+	Since Version
+}
+
+// Write streams the bundle to w, the questions are loaded in batches and the images are copied one by one.
+func (b *Builder) Write(ctx context.Context, w io.Writer, cfg *WriteConfig) (*Manifest, error) {
+	if cfg == nil || cfg.Qualification == nil {
+		return nil, errors.New("cfg.Qualification is required")
+	}
+
+	zw := zip.NewWriter(w)
+	manifest := newManifest(cfg.Qualification, cfg.Since)
+
+	var images []string
+	if err := b.writeFile(zw, manifest, QuestionsFilename, zip.Deflate, func(fw io.Writer) error {
+		var err error
+		images, err = b.writeQuestions(ctx, fw, manifest, cfg)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		if err := b.writeImage(zw, manifest, image); err != nil {
+			return nil, err
+		}
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't encode the manifest")
+	}
+	signature := ed25519.Sign(b.privateKey, manifestJSON)
+	for _, file := range [...]struct {
+		name    string
+		content []byte
+	}{
+		{ManifestFilename, manifestJSON},
+		{SignatureFilename, []byte(base64.StdEncoding.EncodeToString(signature))},
+	} {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't create %s", file.name)
+		}
+		if _, err := fw.Write(file.content); err != nil {
+			return nil, errors.Wrapf(err, "couldn't write %s", file.name)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "couldn't close the archive")
+	}
+	return manifest, nil
+}
+
+// writeQuestions walks through the change feed of the qualification starting right after cfg.Since,
+// writes the updated questions as a JSON array and returns the filenames of their images (including the variants).
+// The version of the bundle is the transaction ID of the last change, the feed lists only the committed transactions,
+// so the next delta bundle doesn't skip any change.
+func (b *Builder) writeQuestions(
+	ctx context.Context,
+	w io.Writer,
+	manifest *Manifest,
+	cfg *WriteConfig,
+) ([]string, error) {
+	var images []string
+	seenImages := make(map[string]bool)
+	// a question can be deleted and restored (moved back to the qualification), only the latest change matters
+	deleted := make(map[int]bool)
+	var deletedIDs []int
+	encoder := json.NewEncoder(w)
+	if _, err := io.WriteString(w, "["); err != nil {
+		return nil, err
+	}
+	cursor := (&question.ChangeCursor{
+		TxID:       int64(cfg.Since),
+		QuestionID: math.MaxInt,
+		Deleted:    true,
+	}).String()
+	if cfg.Since <= 0 {
+		cursor = (&question.ChangeCursor{}).String()
+	}
+	for {
+		changes, err := b.questionUsecase.Changes(ctx, &question.ChangesConfig{
+			QualificationIDs: []int{cfg.Qualification.ID},
+			Cursor:           cursor,
+			Limit:            question.ChangesMaxLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, q := range changes.Updated {
+			delete(deleted, q.ID)
+			if manifest.QuestionCount > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return nil, err
+				}
+			}
+			if err := encoder.Encode(newQuestion(q)); err != nil {
+				return nil, err
+			}
+			manifest.QuestionCount++
+			for _, image := range questionImages(q) {
+				filenames := []string{image}
+				for _, variant := range q.VariantsOf(image) {
+					filenames = append(filenames, variant.Filename)
+				}
+				for _, filename := range filenames {
+					if filename != "" && !seenImages[filename] {
+						seenImages[filename] = true
+						images = append(images, filename)
+					}
+				}
+			}
+		}
+		for _, id := range changes.DeletedIDs {
+			if !deleted[id] {
+				deleted[id] = true
+				deletedIDs = append(deletedIDs, id)
+			}
+		}
+
+		last, err := question.ParseChangeCursor(changes.Cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse the cursor")
+		}
+		if version := Version(last.TxID); version > manifest.Version {
+			manifest.Version = version
+		}
+		if !changes.HasMore {
+			break
+		}
+		cursor = changes.Cursor
+	}
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return nil, err
+	}
+
+	// the full bundle replaces everything the app has, so there is nothing to delete
+	if cfg.Since > 0 {
+		for _, id := range deletedIDs {
+			if deleted[id] {
+				manifest.DeletedIDs = append(manifest.DeletedIDs, id)
+			}
+		}
+	}
+	return images, nil
+}
+
+func (b *Builder) writeImage(zw *zip.Writer, manifest *Manifest, filename string) error {
+	f, err := b.fileStorage.Get(filename)
+	if err != nil {
+		// a missing image shouldn't make the whole bank unavailable offline
+		log.WithError(err).WithField("filename", filename).Warn("Couldn't add the image to the bundle")
+		return nil
+	}
+	defer f.Close()
+	// the images are already compressed
+	return b.writeFile(zw, manifest, ImagesDirectory+filename, zip.Store, func(fw io.Writer) error {
+		_, err := io.Copy(fw, f)
+		return err
+	})
+}
+
+// writeFile adds the file to the archive and to the manifest.
+func (b *Builder) writeFile(
+	zw *zip.Writer,
+	manifest *Manifest,
+	name string,
+	method uint16,
+	write func(w io.Writer) error,
+) error {
+	fw, err := zw.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: method,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't create %s", name)
+	}
+	hash := sha256.New()
+	counter := &countingWriter{}
+	if err := write(io.MultiWriter(fw, hash, counter)); err != nil {
+		return errors.Wrapf(err, "couldn't write %s", name)
+	}
+	manifest.Files = append(manifest.Files, ManifestFile{
+		Path:   name,
+		Size:   counter.n,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	})
+	return nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// ParsePrivateKey decodes a base64-encoded ed25519 seed (32 bytes) or private key (64 bytes).
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decode the key")
+	}
+	switch len(b) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(b), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(b), nil
+	}
+	return nil, errors.Errorf("the key should have %d or %d bytes, got %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(b))
+}
@@ -0,0 +1,109 @@
+package httpdelivery
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"net/http"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/contentbundle"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
+)
+
+const (
+	publicKeyEndpoint = "/content/public-key"
+	bundleEndpoint    = "/content/{slug}"
+	deltaEndpoint     = "/content/{slug}/delta"
+)
+
+var log = logrus.WithField("package", "internal/contentbundle/httpdelivery")
+
+type Config struct {
+	Builder              *contentbundle.Builder
+	QualificationUsecase qualification.Usecase
+}
+
+type handler struct {
+	builder              *contentbundle.Builder
+	qualificationUsecase qualification.Usecase
+}
+
+func Attach(r chi.Router, cfg Config) error {
+	if cfg.Builder == nil {
+		return errors.New("cfg.Builder is required")
+	}
+	if cfg.QualificationUsecase == nil {
+		return errors.New("cfg.QualificationUsecase is required")
+	}
+	h := &handler{
+		builder:              cfg.Builder,
+		qualificationUsecase: cfg.QualificationUsecase,
+	}
+	r.Get(publicKeyEndpoint, h.publicKey)
+	r.Get(bundleEndpoint, h.bundle)
+	r.Get(deltaEndpoint, h.delta)
+	return nil
+}
+
+// publicKey returns the base64-encoded ed25519 key the manifests are signed with.
+func (h *handler) publicKey(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(h.builder.PublicKey())))
+}
+
+// bundle streams all questions of the qualification along with their images.
+func (h *handler) bundle(w http.ResponseWriter, r *http.Request) {
+	h.write(w, r, 0)
+}
+
+// delta streams only the questions changed after the version given in the query string (?since=123456),
+// the version is taken from the manifest of the previous bundle.
+func (h *handler) delta(w http.ResponseWriter, r *http.Request) {
+	since, err := contentbundle.ParseVersion(r.URL.Query().Get("since"))
+	if err != nil || since <= 0 {
+		http.Error(w, messageInvalidVersion, http.StatusBadRequest)
+		return
+	}
+	h.write(w, r, since)
+}
+
+func (h *handler) write(w http.ResponseWriter, r *http.Request, since contentbundle.Version) {
+	if _, err := middleware.UserFromContext(r.Context()); err != nil {
+		http.Error(w, messageMustBeSignedIn, http.StatusUnauthorized)
+		return
+	}
+
+	qual, err := h.qualificationUsecase.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, bundleFilename(qual, since)))
+	w.Header().Set("Cache-Control", "no-store, must-revalidate")
+
+	_, err = h.builder.Write(r.Context(), w, &contentbundle.WriteConfig{
+		Qualification: qual,
+		Since:         since,
+	})
+	// the response has already been started, so the status code can't be changed anymore
+	if err != nil {
+		log.
+			WithError(err).
+			WithField("qualificationID", qual.ID).
+			WithField("since", since).
+			Warn("Couldn't write the content bundle")
+	}
+}
+
+func bundleFilename(qual *model.Qualification, since contentbundle.Version) string {
+	if since > 0 {
+		return fmt.Sprintf("%s-since-%s.zip", qual.Slug, since)
+	}
+	return qual.Slug + ".zip"
+}
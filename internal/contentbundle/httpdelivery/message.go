@@ -0,0 +1,6 @@
+package httpdelivery
+
+const (
+	messageMustBeSignedIn = "Musisz być zalogowany."
+	messageInvalidVersion = "Niepoprawna wersja pakietu."
+)
@@ -0,0 +1,79 @@
+package contentbundle
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+const (
+	FormatVersion = 1
+
+	ManifestFilename  = "manifest.json"
+	SignatureFilename = "manifest.sig"
+	QuestionsFilename = "questions.json"
+	ImagesDirectory   = "images/"
+)
+
+// Version identifies the state of the question bank, it's the ID of the last transaction (txid_current())
+// whose changes are included in the bundle.
+type Version int64
+
+func ParseVersion(s string) (Version, error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || v < 0 {
+		return 0, err
+	}
+	return Version(v), nil
+}
+
+func (v Version) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+type ManifestQualification struct {
+	ID   int    `json:"id"`
+	Slug string `json:"slug"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type ManifestFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest describes the content of a bundle, it's signed with ed25519 and the signature is stored in SignatureFilename.
+type Manifest struct {
+	FormatVersion int                   `json:"formatVersion"`
+	Qualification ManifestQualification `json:"qualification"`
+	// Version is the version of the bank the bundle brings the app to.
+	Version Version `json:"version,string"`
+	// BaseVersion is set only in the delta bundles, they contain the questions changed after this version.
+	BaseVersion   Version `json:"baseVersion,string,omitempty"`
+	QuestionCount int     `json:"questionCount"`
+	// DeletedIDs lists the questions the app has to remove after applying the delta bundle,
+	// it's always empty in the full bundles.
+	DeletedIDs  []int          `json:"deletedIDs"`
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Files       []ManifestFile `json:"files"`
+}
+
+func newManifest(q *model.Qualification, baseVersion Version) *Manifest {
+	return &Manifest{
+		FormatVersion: FormatVersion,
+		Qualification: ManifestQualification{
+			ID:   q.ID,
+			Slug: q.Slug,
+			Code: q.Code,
+			Name: q.Name,
+		},
+		Version:     baseVersion,
+		BaseVersion: baseVersion,
+		DeletedIDs:  []int{},
+		GeneratedAt: time.Now(),
+		Files:       []ManifestFile{},
+	}
+}
@@ -0,0 +1,70 @@
+package contentbundle
+
+import (
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+// Question is a question as stored in questions.json.
+// The bundles are meant for studying offline, so unlike the API they include the answer key.
+type Question struct {
+	ID              int          `json:"id"`
+	From            string       `json:"from"`
+	Content         string       `json:"content"`
+	Explanation     string       `json:"explanation"`
+	CorrectAnswer   model.Answer `json:"correctAnswer"`
+	Image           string       `json:"image"`
+	AnswerA         string       `json:"answerA"`
+	AnswerAImage    string       `json:"answerAImage"`
+	AnswerB         string       `json:"answerB"`
+	AnswerBImage    string       `json:"answerBImage"`
+	AnswerC         string       `json:"answerC"`
+	AnswerCImage    string       `json:"answerCImage"`
+	AnswerD         string       `json:"answerD"`
+	AnswerDImage    string       `json:"answerDImage"`
+	QualificationID int          `json:"qualificationID"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+	// ImageVariants maps the images of the question to their downscaled copies (sorted by width, ascending),
+	// the copies are stored in the images directory too.
+	ImageVariants map[string][]*model.ImageVariant `json:"imageVariants"`
+}
+
+func newQuestion(q *model.Question) *Question {
+	return &Question{
+		ID:              q.ID,
+		From:            q.From,
+		Content:         q.Content,
+		Explanation:     q.Explanation,
+		CorrectAnswer:   q.CorrectAnswer,
+		Image:           q.Image,
+		AnswerA:         q.AnswerA,
+		AnswerAImage:    q.AnswerAImage,
+		AnswerB:         q.AnswerB,
+		AnswerBImage:    q.AnswerBImage,
+		AnswerC:         q.AnswerC,
+		AnswerCImage:    q.AnswerCImage,
+		AnswerD:         q.AnswerD,
+		AnswerDImage:    q.AnswerDImage,
+		QualificationID: q.QualificationID,
+		CreatedAt:       q.CreatedAt,
+		UpdatedAt:       q.UpdatedAt,
+		ImageVariants:   imageVariants(q),
+	}
+}
+
+// imageVariants returns the variants of the images the question still uses.
+func imageVariants(q *model.Question) map[string][]*model.ImageVariant {
+	variants := make(map[string][]*model.ImageVariant)
+	for _, image := range questionImages(q) {
+		if v := q.VariantsOf(image); len(v) > 0 {
+			variants[image] = v
+		}
+	}
+	return variants
+}
+
+func questionImages(q *model.Question) []string {
+	return []string{q.Image, q.AnswerAImage, q.AnswerBImage, q.AnswerCImage, q.AnswerDImage}
+}
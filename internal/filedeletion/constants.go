@@ -0,0 +1,14 @@
+package filedeletion
+
+import "time"
+
+const (
+	DefaultInterval  = 30 * time.Second
+	DefaultBatchSize = 100
+	// ClaimDuration is the time a claimed deletion stays invisible to the other workers,
+	// it's processed again after that time if the worker hasn't completed it (e.g. because it has crashed).
+	ClaimDuration = 5 * time.Minute
+	// BaseRetryDelay is the delay after the first failed attempt, it doubles with every next attempt up to MaxRetryDelay.
+	BaseRetryDelay = time.Minute
+	MaxRetryDelay  = 6 * time.Hour
+)
@@ -0,0 +1,18 @@
+package filedeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Repository interface {
+	// Claim returns up to limit deletions that are due and postpones them until claimedUntil,
+	// so that they aren't processed by the other workers in the meantime. Every claim counts as an attempt.
+	Claim(ctx context.Context, limit int, claimedUntil time.Time) ([]*model.FileDeletion, error)
+	// Complete removes the processed deletions.
+	Complete(ctx context.Context, ids []int) error
+	// Retry postpones the failed deletion.
+	Retry(ctx context.Context, id int, processAfter time.Time, reason string) error
+}
@@ -0,0 +1,7 @@
+package repository
+
+const (
+	messageFailedToFetchModel  = "Wystąpił błąd podczas pobierania plików do usunięcia."
+	messageFailedToSaveModel   = "Wystąpił błąd podczas zapisywania pliku do usunięcia."
+	messageFailedToDeleteModel = "Wystąpił błąd podczas usuwania pliku do usunięcia."
+)
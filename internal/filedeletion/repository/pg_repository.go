@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/filedeletion"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ filedeletion.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Claim(ctx context.Context, limit int, claimedUntil time.Time) ([]*model.FileDeletion, error) {
+	// SKIP LOCKED lets several instances of the worker claim different deletions at the same time
+	due := repo.
+		Model(&model.FileDeletion{}).
+		Column("id").
+		Where(gopgutil.BuildConditionLTE("process_after"), time.Now()).
+		Order("process_after ASC", "id ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+	items := make([]*model.FileDeletion, 0)
+	if _, err := repo.
+		Model(&items).
+		Context(ctx).
+		Set("process_after = ?", claimedUntil).
+		Set("attempts = ? + 1", pg.Ident("attempts")).
+		Where(gopgutil.BuildConditionIn("?"), gopgutil.AddAliasToColumnName("id", "file_deletion"), due).
+		Returning("*").
+		Update(); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, nil
+}
+
+func (repo *PGRepository) Complete(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := repo.
+		Model(&model.FileDeletion{}).
+		Context(ctx).
+		Where(gopgutil.BuildConditionArray("id"), pg.Array(ids)).
+		Delete(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToDeleteModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Retry(ctx context.Context, id int, processAfter time.Time, reason string) error {
+	if _, err := repo.
+		Model(&model.FileDeletion{}).
+		Context(ctx).
+		Set("process_after = ?", processAfter).
+		Set("last_error = ?", reason).
+		Where(gopgutil.BuildConditionEquals("id"), id).
+		Update(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/filedeletion"
+)
+
+var log = logrus.WithField("package", "internal/filedeletion/worker")
+
+type Config struct {
+	FileDeletionRepository filedeletion.Repository
+	FileStorage            fstorage.FileStorage
+	// Interval is the time between the checks for new deletions, filedeletion.DefaultInterval is used when it's 0.
+	Interval time.Duration
+	// BatchSize is the maximum number of deletions claimed at once, filedeletion.DefaultBatchSize is used when it's 0.
+	BatchSize int
+}
+
+// Worker removes the files scheduled for deletion from the file storage, the failed deletions are retried with backoff.
+type Worker struct {
+	fileDeletionRepository filedeletion.Repository
+	fileStorage            fstorage.FileStorage
+	interval               time.Duration
+	batchSize              int
+}
+
+func New(cfg *Config) (*Worker, error) {
+	if cfg == nil || cfg.FileDeletionRepository == nil {
+		return nil, errors.New("cfg.FileDeletionRepository is required")
+	}
+	if cfg.FileStorage == nil {
+		return nil, errors.New("cfg.FileStorage is required")
+	}
+	w := &Worker{
+		fileDeletionRepository: cfg.FileDeletionRepository,
+		fileStorage:            cfg.FileStorage,
+		interval:               cfg.Interval,
+		batchSize:              cfg.BatchSize,
+	}
+	if w.interval <= 0 {
+		w.interval = filedeletion.DefaultInterval
+	}
+	if w.batchSize <= 0 {
+		w.batchSize = filedeletion.DefaultBatchSize
+	}
+	return w, nil
+}
+
+// Run processes the deletions until the context is canceled.
+func (w *Worker) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		processed, err := w.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.WithError(err).Warn("Couldn't process the file deletions")
+		}
+		// a full batch means there may be more deletions waiting
+		if err == nil && processed == w.batchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(w.interval)
+		}
+	}
+}
+
+// ProcessBatch claims a batch of the due deletions and removes their files, it returns the number of claimed deletions.
+func (w *Worker) ProcessBatch(ctx context.Context) (int, error) {
+	deletions, err := w.fileDeletionRepository.Claim(ctx, w.batchSize, time.Now().Add(filedeletion.ClaimDuration))
+	if err != nil {
+		return 0, err
+	}
+
+	completed := make([]int, 0, len(deletions))
+	for _, deletion := range deletions {
+		if err := w.fileStorage.Remove(deletion.Filename); err != nil {
+			log.
+				WithError(err).
+				WithField("filename", deletion.Filename).
+				WithField("attempts", deletion.Attempts).
+				Warn("Couldn't remove the file")
+			processAfter := time.Now().Add(retryDelay(deletion.Attempts))
+			if err := w.fileDeletionRepository.Retry(ctx, deletion.ID, processAfter, err.Error()); err != nil {
+				return len(deletions), err
+			}
+			continue
+		}
+		completed = append(completed, deletion.ID)
+	}
+
+	if err := w.fileDeletionRepository.Complete(ctx, completed); err != nil {
+		return len(deletions), err
+	}
+	return len(deletions), nil
+}
+
+func retryDelay(attempts int) time.Duration {
+	delay := filedeletion.BaseRetryDelay
+	for i := 1; i < attempts && delay < filedeletion.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > filedeletion.MaxRetryDelay {
+		return filedeletion.MaxRetryDelay
+	}
+	return delay
+}
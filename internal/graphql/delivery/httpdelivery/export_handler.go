@@ -0,0 +1,98 @@
+package httpdelivery
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question/exporter"
+)
+
+const (
+	exportQuestionsEndpoint = "/export/questions"
+)
+
+var log = logrus.WithField("package", "internal/graphql/delivery/httpdelivery")
+
+type exportHandler struct {
+	questionUsecase      question.Usecase
+	qualificationUsecase qualification.Usecase
+	fileStorage          fstorage.FileStorage
+}
+
+// exportQuestions streams all questions of the qualification given in the query string (?qualificationID=1&format=csv).
+// The correct answers are part of the export, so it's available only to admins.
+func (h *exportHandler) exportQuestions(w http.ResponseWriter, r *http.Request) {
+	u, err := middleware.UserFromContext(r.Context())
+	if err != nil {
+		http.Error(w, messageMustBeSignedIn, http.StatusUnauthorized)
+		return
+	}
+	if u.Role != model.RoleAdmin {
+		http.Error(w, messageUnauthorized, http.StatusForbidden)
+		return
+	}
+
+	qualificationID, err := strconv.Atoi(r.URL.Query().Get("qualificationID"))
+	if err != nil || qualificationID <= 0 {
+		http.Error(w, messageInvalidQualificationID, http.StatusBadRequest)
+		return
+	}
+	format := exporter.Format(strings.ToLower(r.URL.Query().Get("format")))
+	if format == "" {
+		format = exporter.FormatJSONLines
+	}
+	if !format.IsValid() {
+		http.Error(w, messageUnsupportedExportFormat, http.StatusBadRequest)
+		return
+	}
+
+	qual, err := h.qualificationUsecase.GetByID(r.Context(), qualificationID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	disposition := "attachment"
+	if format == exporter.FormatHTML {
+		disposition = "inline"
+	}
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set(
+		"Content-Disposition",
+		fmt.Sprintf(`%s; filename="%s.%s"`, disposition, qual.Slug, format.Extension()),
+	)
+	w.Header().Set("Cache-Control", "no-store, must-revalidate")
+
+	cfg := &exporter.Config{
+		Title: fmt.Sprintf("%s - %s", qual.Code, qual.Name),
+	}
+	// the signed URLs expire, so the data exports hold the stored filenames,
+	// only the worksheet, which is displayed right away, links to the images
+	if format == exporter.FormatHTML {
+		cfg.ImageURL = h.fileStorage.URL
+	}
+	writer, err := exporter.NewWriter(format, w, cfg)
+	if err == nil {
+		err = h.questionUsecase.Iterate(r.Context(), &question.IterateConfig{
+			Filter: &model.QuestionFilter{
+				QualificationID: []int{qual.ID},
+			},
+		}, writer.Write)
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	// the response has already been started, so the status code can't be changed anymore
+	if err != nil {
+		log.WithError(err).WithField("qualificationID", qual.ID).Warn("Couldn't export the questions")
+	}
+}
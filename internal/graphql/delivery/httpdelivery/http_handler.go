@@ -36,12 +36,27 @@ func Attach(r chi.Router, cfg Config) error {
 	if cfg.Resolver == nil {
 		return errors.New("cfg.Resolver is required")
 	}
+	if cfg.Resolver.FileStorage == nil {
+		return errors.New("cfg.Resolver.FileStorage is required")
+	}
 	gqlHandler := graphqlHandler(prepareConfig(cfg.Resolver, cfg.Directive))
 	r.Get(graphqlEndpoint, gqlHandler)
 	r.Post(graphqlEndpoint, gqlHandler)
 	if appmode.Equals(appmode.DevelopmentMode) {
 		r.Get(playgroundEndpoint, playgroundHandler())
 	}
+	h := &exportHandler{
+		questionUsecase:      cfg.Resolver.QuestionUsecase,
+		qualificationUsecase: cfg.Resolver.QualificationUsecase,
+		fileStorage:          cfg.Resolver.FileStorage,
+	}
+	r.Get(exportQuestionsEndpoint, h.exportQuestions)
+	imgHandler, err := newImageHandler(cfg.Resolver.FileStorage)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create the image handler")
+	}
+	r.Get(imagesEndpoint, imgHandler.serveImage)
+	r.Head(imagesEndpoint, imgHandler.serveImage)
 	return nil
 }
 
@@ -85,5 +100,6 @@ func prepareConfig(r *resolvers.Resolver, d *directive.Directive) generated.Conf
 	}
 	cfg.Directives.Authenticated = d.Authenticated
 	cfg.Directives.HasRole = d.HasRole
+	cfg.Directives.AnswerKey = d.AnswerKey
 	return cfg
 }
@@ -0,0 +1,139 @@
+package httpdelivery
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+)
+
+const (
+	imagesEndpoint = "/images/{filename}"
+	// the filenames are generated for every upload, so the content under a name never changes
+	imageCacheControlMaxAge = 365 * 24 * time.Hour
+	imageETagCacheSize      = 10000
+)
+
+type imageHandler struct {
+	fileStorage fstorage.FileStorage
+	// etags caches the content hashes, so that a file is hashed only once
+	etags *lru.Cache
+}
+
+func newImageHandler(fileStorage fstorage.FileStorage) (*imageHandler, error) {
+	etags, err := lru.New(imageETagCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &imageHandler{
+		fileStorage: fileStorage,
+		etags:       etags,
+	}, nil
+}
+
+// serveImage serves a file from the storage, it supports the conditional (ETag/Last-Modified) and the range requests.
+// If the URLs are signed, a valid signature is always required.
+func (h *imageHandler) serveImage(w http.ResponseWriter, r *http.Request) {
+	filename := chi.URLParam(r, "filename")
+	if filename == "" || path.Base(filename) != filename || strings.HasPrefix(filename, ".") {
+		http.Error(w, messageImageNotFound, http.StatusNotFound)
+		return
+	}
+
+	// the API signs the URLs for every user, being signed in doesn't give access to the files whose URLs haven't been handed out
+	if err := h.fileStorage.VerifyURL(filename, r.URL.Query()); err != nil {
+		http.Error(w, messageInvalidImageURL, http.StatusForbidden)
+		return
+	}
+
+	fi, err := h.fileStorage.Stat(filename)
+	if err == fstorage.ErrNotExist {
+		http.Error(w, messageImageNotFound, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.internalError(w, err, filename)
+		return
+	}
+
+	etag, err := h.etag(fi)
+	if err == fstorage.ErrNotExist {
+		http.Error(w, messageImageNotFound, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.internalError(w, err, filename)
+		return
+	}
+
+	f, err := h.fileStorage.Get(filename)
+	if err == fstorage.ErrNotExist {
+		http.Error(w, messageImageNotFound, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.internalError(w, err, filename)
+		return
+	}
+	defer f.Close()
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		// the range requests need a seeker, the images are small enough to be buffered
+		b, err := io.ReadAll(f)
+		if err != nil {
+			h.internalError(w, err, filename)
+			return
+		}
+		content = bytes.NewReader(b)
+	}
+
+	cacheability := "public"
+	if h.fileStorage.SigningEnabled() {
+		cacheability = "private"
+	}
+	w.Header().Set(
+		"Cache-Control",
+		fmt.Sprintf("%s, max-age=%d, immutable", cacheability, int(imageCacheControlMaxAge.Seconds())),
+	)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Content-Type", fi.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, filename, fi.ModTime, content)
+}
+
+// etag returns the SHA-256 hash of the file content, the modification time and the size are a part of the cache key,
+// so that a hash of a replaced file isn't reused.
+func (h *imageHandler) etag(fi *fstorage.FileInfo) (string, error) {
+	key := fmt.Sprintf("%s:%d:%d", fi.Name, fi.Size, fi.ModTime.UnixNano())
+	if etag, ok := h.etags.Get(key); ok {
+		return etag.(string), nil
+	}
+
+	f, err := h.fileStorage.Get(fi.Name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	etag := `"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+	h.etags.Add(key, etag)
+	return etag, nil
+}
+
+func (h *imageHandler) internalError(w http.ResponseWriter, err error, filename string) {
+	log.WithError(err).WithField("filename", filename).Warn("Couldn't serve the image")
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,10 @@
+package httpdelivery
+
+const (
+	messageMustBeSignedIn          = "Musisz być zalogowany."
+	messageUnauthorized            = "Brak uprawnień."
+	messageInvalidQualificationID  = "Niepoprawne ID kwalifikacji."
+	messageUnsupportedExportFormat = "Nieobsługiwany format eksportu, dostępne formaty: jsonl, csv, html."
+	messageImageNotFound           = "Nie znaleziono obrazka."
+	messageInvalidImageURL         = "Link do obrazka jest nieprawidłowy lub wygasł."
+)
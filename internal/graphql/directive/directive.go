@@ -13,6 +13,10 @@ import (
 
 type Directive struct{}
 
+type answerRevealer interface {
+	IsAnswerRevealed() bool
+}
+
 func (d *Directive) Authenticated(ctx context.Context, _ interface{}, next graphql.Resolver, yes bool) (interface{}, error) {
 	_, err := middleware.UserFromContext(ctx)
 	if yes && err != nil {
@@ -35,3 +39,15 @@ func (d *Directive) HasRole(ctx context.Context, _ interface{}, next graphql.Res
 
 	return next(ctx)
 }
+
+// AnswerKey hides the field (resolves it to null) unless the user is an admin or the answer has been revealed.
+func (d *Directive) AnswerKey(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	if user, err := middleware.UserFromContext(ctx); err == nil && user.Role == model.RoleAdmin {
+		return next(ctx)
+	}
+	if revealer, ok := obj.(answerRevealer); ok && revealer.IsAnswerRevealed() {
+		return next(ctx)
+	}
+
+	return nil, nil
+}
@@ -3,9 +3,16 @@
 package generated
 
 import (
+	"time"
+
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 )
 
+type LoginLockoutList struct {
+	Total int                   `json:"total"`
+	Items []*model.LoginLockout `json:"items"`
+}
+
 type ProfessionList struct {
 	Total int                 `json:"total"`
 	Items []*model.Profession `json:"items"`
@@ -21,12 +28,20 @@ type QuestionList struct {
 	Items []*model.Question `json:"items"`
 }
 
+type TestAttemptList struct {
+	Total int                  `json:"total"`
+	Items []*model.TestAttempt `json:"items"`
+}
+
 type UserList struct {
 	Total int           `json:"total"`
 	Items []*model.User `json:"items"`
 }
 
 type UserWithToken struct {
-	Token string      `json:"token"`
-	User  *model.User `json:"user"`
+	Token                 string      `json:"token"`
+	ExpiresAt             time.Time   `json:"expiresAt"`
+	RefreshToken          string      `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time   `json:"refreshTokenExpiresAt"`
+	User                  *model.User `json:"user"`
 }
@@ -1,14 +1,19 @@
 package querycomplexity
 
 import (
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/Kichiyaki/goutil/safeptr"
+	"time"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/profession"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/user"
 )
 
@@ -19,6 +24,8 @@ const (
 	qualificationsTotalFieldComplexity = 100
 	questionsTotalFieldComplexity      = 300
 	usersTotalFieldComplexity          = 50
+	loginLockoutsTotalFieldComplexity  = 50
+	testAttemptsTotalFieldComplexity   = 100
 )
 
 func GetComplexityLimitExtension() *extension.ComplexityLimit {
@@ -78,7 +85,28 @@ func GetComplexityRoot() generated.ComplexityRoot {
 			1,
 		)
 	}
-	complexityRoot.Query.GenerateTest = func(childComplexity int, qualificationIDs []int, limit *int) int {
+	complexityRoot.Query.GenerateTest = func(
+		childComplexity int,
+		qualificationIDs []int,
+		limit *int,
+		allocation *model.TestAllocation,
+		counts []*model.QualificationQuestionCountInput,
+	) int {
+		return computeComplexity(
+			childComplexity,
+			safeptr.SafeIntPointer(limit, question.TestMaxLimit),
+			0,
+			3,
+		)
+	}
+	complexityRoot.Query.GenerateSeededTest = func(
+		childComplexity int,
+		qualificationIDs []int,
+		limit *int,
+		seed *int,
+		allocation *model.TestAllocation,
+		counts []*model.QualificationQuestionCountInput,
+	) int {
 		return computeComplexity(
 			childComplexity,
 			safeptr.SafeIntPointer(limit, question.TestMaxLimit),
@@ -86,6 +114,28 @@ func GetComplexityRoot() generated.ComplexityRoot {
 			3,
 		)
 	}
+	complexityRoot.Query.QuestionChanges = func(
+		childComplexity int,
+		since *time.Time,
+		qualificationIDs []int,
+		cursor *string,
+		limit *int,
+	) int {
+		return computeComplexity(
+			childComplexity,
+			safeptr.SafeIntPointer(limit, question.ChangesDefaultLimit),
+			0,
+			1,
+		)
+	}
+	complexityRoot.Query.GenerateReview = func(childComplexity int, qualificationIDs []int, limit *int) int {
+		return computeComplexity(
+			childComplexity,
+			safeptr.SafeIntPointer(limit, review.ReviewMaxLimit),
+			0,
+			3,
+		)
+	}
 
 	complexityRoot.UserList.Total = getCountComplexity
 	complexityRoot.Query.Users = func(
@@ -103,6 +153,81 @@ func GetComplexityRoot() generated.ComplexityRoot {
 		)
 	}
 
+	complexityRoot.TestAttempt.Questions = func(childComplexity int) int {
+		return 10 + question.TestMaxLimit*childComplexity
+	}
+	complexityRoot.TestAttemptList.Total = getCountComplexity
+	complexityRoot.Query.MyTestAttempts = func(
+		childComplexity int,
+		filter *model.TestAttemptFilter,
+		limit *int,
+		offset *int,
+		sort []string,
+	) int {
+		return computeComplexity(
+			childComplexity,
+			safeptr.SafeIntPointer(limit, testattempt.FetchDefaultLimit),
+			testAttemptsTotalFieldComplexity,
+			1,
+		)
+	}
+
+	complexityRoot.QualificationStatistics.Qualification = func(childComplexity int) int {
+		return 10 + childComplexity
+	}
+	complexityRoot.Query.MyStatistics = func(childComplexity int, days *int) int {
+		return (complexityLimit / 10) + childComplexity
+	}
+	complexityRoot.Query.UserStatistics = func(childComplexity int, userID int, days *int) int {
+		return (complexityLimit / 10) + childComplexity
+	}
+
+	complexityRoot.LoginLockoutList.Total = getCountComplexity
+	complexityRoot.Query.LoginLockouts = func(
+		childComplexity int,
+		filter *model.LoginLockoutFilter,
+		limit *int,
+		offset *int,
+		sort []string,
+	) int {
+		return computeComplexity(
+			childComplexity,
+			safeptr.SafeIntPointer(limit, loginlimiter.FetchMaxLimit),
+			loginLockoutsTotalFieldComplexity,
+			1,
+		)
+	}
+
+	complexityRoot.Mutation.StartTest = func(
+		childComplexity int,
+		qualificationIDs []int,
+		limit *int,
+		seed *int,
+		shuffleAnswers *bool,
+	) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
+	complexityRoot.Mutation.StartExam = func(childComplexity int, qualificationID int, seed *int, shuffleAnswers *bool) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
+	complexityRoot.Mutation.StartSharedTest = func(childComplexity int, code string, shuffleAnswers *bool) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
+	complexityRoot.Mutation.ShareTest = func(childComplexity int, input model.SharedTestInput) int {
+		return (complexityLimit / 10) + childComplexity
+	}
+
+	complexityRoot.Mutation.SubmitTest = func(
+		childComplexity int,
+		id int,
+		answers []*model.TestAttemptAnswerInput,
+	) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
 	complexityRoot.Mutation.CreateProfession = func(childComplexity int, input model.ProfessionInput) int {
 		return (complexityLimit / 5) + childComplexity
 	}
@@ -131,6 +256,34 @@ func GetComplexityRoot() generated.ComplexityRoot {
 		return (complexityLimit / 2) + childComplexity
 	}
 
+	complexityRoot.Mutation.ChangePassword = func(childComplexity int, currentPassword string, newPassword string) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
+	complexityRoot.Mutation.SignUp = func(childComplexity int, input model.UserInput) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
+	complexityRoot.Mutation.ActivateAccount = func(childComplexity int, token string) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
+	complexityRoot.Mutation.ResendActivationEmail = func(childComplexity int, email string) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
+	complexityRoot.Mutation.RequestPasswordReset = func(childComplexity int, email string) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
+	complexityRoot.Mutation.ResetPassword = func(childComplexity int, token string, newPassword string) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
+	complexityRoot.Mutation.RefreshToken = func(childComplexity int, refreshToken string) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
 	complexityRoot.Mutation.UpdateManyUsers = func(
 		childComplexity int,
 		ids []int,
@@ -179,10 +332,24 @@ func GetComplexityRoot() generated.ComplexityRoot {
 		return (complexityLimit / 4) + childComplexity
 	}
 
+	complexityRoot.Mutation.ImportQuestions = func(
+		childComplexity int,
+		file graphql.Upload,
+		images *graphql.Upload,
+		format *model.QuestionImportFormat,
+		dryRun *bool,
+	) int {
+		return (complexityLimit / 2) + childComplexity
+	}
+
 	complexityRoot.Mutation.DeleteUsers = func(childComplexity int, ids []int) int {
 		return (complexityLimit / 5) + childComplexity
 	}
 
+	complexityRoot.Mutation.RevokeUserSessions = func(childComplexity int, userID int) int {
+		return (complexityLimit / 5) + childComplexity
+	}
+
 	return complexityRoot
 }
 
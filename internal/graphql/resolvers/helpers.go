@@ -4,6 +4,11 @@ import (
 	"context"
 
 	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/auth"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 )
 
 func shouldCount(ctx context.Context) bool {
@@ -14,3 +19,32 @@ func shouldCount(ctx context.Context) bool {
 	}
 	return false
 }
+
+// currentSessionIDs returns a slice containing the ID of the session the request has been authenticated with (if any).
+func currentSessionIDs(ctx context.Context) []string {
+	sess, err := middleware.SessionFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return []string{sess.ID}
+}
+
+// revealAnswersIfSubmitted reveals the correct answers once the attempt has been submitted (see the @answerKey directive).
+func revealAnswersIfSubmitted(attempt *model.TestAttempt, questions []*model.Question) []*model.Question {
+	if attempt.IsSubmitted() {
+		for _, q := range questions {
+			q.RevealAnswer()
+		}
+	}
+	return questions
+}
+
+func newUserWithToken(u *model.User, tokens *auth.TokenPair) *generated.UserWithToken {
+	return &generated.UserWithToken{
+		Token:                 tokens.AccessToken,
+		ExpiresAt:             tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		User:                  u,
+	}
+}
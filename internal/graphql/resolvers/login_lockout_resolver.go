@@ -0,0 +1,41 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"github.com/Kichiyaki/goutil/safeptr"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+func (r *mutationResolver) ClearLoginLockouts(ctx context.Context, keys []string) ([]*model.LoginLockout, error) {
+	return r.LoginLimiterUsecase.Delete(ctx, &model.LoginLockoutFilter{
+		Key: keys,
+	})
+}
+
+func (r *queryResolver) LoginLockouts(
+	ctx context.Context,
+	filter *model.LoginLockoutFilter,
+	limit *int,
+	offset *int,
+	sort []string,
+) (*generated.LoginLockoutList, error) {
+	var err error
+	list := &generated.LoginLockoutList{}
+	list.Items, list.Total, err = r.LoginLimiterUsecase.Fetch(
+		ctx,
+		&loginlimiter.FetchConfig{
+			Count:  shouldCount(ctx),
+			Filter: filter,
+			Limit:  safeptr.SafeIntPointer(limit, loginlimiter.FetchMaxLimit),
+			Offset: safeptr.SafeIntPointer(offset, 0),
+			Sort:   sort,
+		},
+	)
+	return list, err
+}
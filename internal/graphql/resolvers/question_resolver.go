@@ -4,13 +4,18 @@ package resolvers
 // will be copied through when generating and any unknown code will be moved to the end.
 
 import (
+	"archive/zip"
 	"context"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/Kichiyaki/goutil/safeptr"
+	"time"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question/importer"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
 )
 
 func (r *mutationResolver) CreateQuestion(ctx context.Context, input model.QuestionInput) (*model.Question, error) {
@@ -27,10 +32,108 @@ func (r *mutationResolver) DeleteQuestions(ctx context.Context, ids []int) ([]*m
 	})
 }
 
-func (r *queryResolver) GenerateTest(ctx context.Context, qualificationIDs []int, limit *int) ([]*model.Question, error) {
-	return r.QuestionUsecase.GenerateTest(ctx, &question.GenerateTestConfig{
+func (r *mutationResolver) ImportQuestions(
+	ctx context.Context,
+	file graphql.Upload,
+	images *graphql.Upload,
+	format *model.QuestionImportFormat,
+	dryRun *bool,
+) (*model.QuestionImportResult, error) {
+	// an unknown extension is reported by importer.Parse
+	f, _ := importer.FormatFromFilename(file.Filename)
+	if format != nil {
+		f = *format
+	}
+
+	var archive *zip.Reader
+	if images != nil {
+		var err error
+		archive, err = importer.NewImageArchive(images.File)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := importer.Parse(f, file.File, archive)
+	if err != nil {
+		return nil, err
+	}
+	return r.QuestionUsecase.Import(ctx, &question.ImportConfig{
+		Rows:   rows,
+		DryRun: safeptr.SafeBoolPointer(dryRun, false),
+	})
+}
+
+func (r *queryResolver) GenerateTest(
+	ctx context.Context,
+	qualificationIDs []int,
+	limit *int,
+	allocation *model.TestAllocation,
+	counts []*model.QualificationQuestionCountInput,
+) ([]*model.Question, error) {
+	return r.QuestionUsecase.GenerateTest(ctx, newGenerateTestConfig(qualificationIDs, limit, nil, allocation, counts))
+}
+
+func (r *queryResolver) GenerateSeededTest(
+	ctx context.Context,
+	qualificationIDs []int,
+	limit *int,
+	seed *int,
+	allocation *model.TestAllocation,
+	counts []*model.QualificationQuestionCountInput,
+) (*model.GeneratedTest, error) {
+	cfg := newGenerateTestConfig(qualificationIDs, limit, seed, allocation, counts)
+	questions, err := r.QuestionUsecase.GenerateTest(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewGeneratedTest(cfg.Seed, questions), nil
+}
+
+func (r *queryResolver) QuestionChanges(
+	ctx context.Context,
+	since *time.Time,
+	qualificationIDs []int,
+	cursor *string,
+	limit *int,
+) (*model.QuestionChanges, error) {
+	cfg := &question.ChangesConfig{
+		QualificationIDs: qualificationIDs,
+		Cursor:           safeptr.SafeStringPointer(cursor, ""),
+		Limit:            safeptr.SafeIntPointer(limit, question.ChangesDefaultLimit),
+	}
+	if since != nil {
+		cfg.Since = *since
+	}
+	return r.QuestionUsecase.Changes(ctx, cfg)
+}
+
+func (r *qualificationQuestionCountResolver) Qualification(
+	ctx context.Context,
+	obj *model.QualificationQuestionCount,
+) (*model.Qualification, error) {
+	if obj != nil && obj.Qualification != nil {
+		return obj.Qualification, nil
+	}
+
+	if obj != nil && obj.QualificationID > 0 {
+		if dataloader, err := middleware.DataLoaderFromContext(ctx); err == nil && dataloader != nil {
+			return dataloader.QualificationByID.Load(obj.QualificationID)
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) GenerateReview(ctx context.Context, qualificationIDs []int, limit *int) ([]*model.Question, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.ReviewUsecase.GenerateReview(ctx, &review.GenerateReviewConfig{
+		UserID:         u.ID,
 		Qualifications: qualificationIDs,
-		Limit:          safeptr.SafeIntPointer(limit, question.TestMaxLimit),
+		Limit:          safeptr.SafeIntPointer(limit, review.ReviewMaxLimit),
 	})
 }
 
@@ -69,3 +172,84 @@ func (r *questionResolver) Qualification(ctx context.Context, obj *model.Questio
 
 	return nil, nil
 }
+
+func (r *questionResolver) Image(ctx context.Context, obj *model.Question) (*string, error) {
+	return r.imageURL(obj.Image), nil
+}
+
+func (r *questionResolver) AnswerAImage(ctx context.Context, obj *model.Question) (*string, error) {
+	return r.imageURL(obj.AnswerAImage), nil
+}
+
+func (r *questionResolver) AnswerBImage(ctx context.Context, obj *model.Question) (*string, error) {
+	return r.imageURL(obj.AnswerBImage), nil
+}
+
+func (r *questionResolver) AnswerCImage(ctx context.Context, obj *model.Question) (*string, error) {
+	return r.imageURL(obj.AnswerCImage), nil
+}
+
+func (r *questionResolver) AnswerDImage(ctx context.Context, obj *model.Question) (*string, error) {
+	return r.imageURL(obj.AnswerDImage), nil
+}
+
+func (r *questionResolver) ImageVariants(ctx context.Context, obj *model.Question) ([]*model.ImageVariant, error) {
+	return imageVariants(obj, obj.Image), nil
+}
+
+func (r *questionResolver) AnswerAImageVariants(ctx context.Context, obj *model.Question) ([]*model.ImageVariant, error) {
+	return imageVariants(obj, obj.AnswerAImage), nil
+}
+
+func (r *questionResolver) AnswerBImageVariants(ctx context.Context, obj *model.Question) ([]*model.ImageVariant, error) {
+	return imageVariants(obj, obj.AnswerBImage), nil
+}
+
+func (r *questionResolver) AnswerCImageVariants(ctx context.Context, obj *model.Question) ([]*model.ImageVariant, error) {
+	return imageVariants(obj, obj.AnswerCImage), nil
+}
+
+func (r *questionResolver) AnswerDImageVariants(ctx context.Context, obj *model.Question) ([]*model.ImageVariant, error) {
+	return imageVariants(obj, obj.AnswerDImage), nil
+}
+
+func (r *imageVariantResolver) URL(ctx context.Context, obj *model.ImageVariant) (string, error) {
+	return r.FileStorage.URL(obj.Filename), nil
+}
+
+// imageVariants never returns nil, the variant fields are non-nullable.
+func imageVariants(obj *model.Question, image string) []*model.ImageVariant {
+	if variants := obj.VariantsOf(image); variants != nil {
+		return variants
+	}
+	return []*model.ImageVariant{}
+}
+
+func (r *questionResolver) imageURL(filename string) *string {
+	u := r.FileStorage.URL(filename)
+	return &u
+}
+
+func newGenerateTestConfig(
+	qualificationIDs []int,
+	limit *int,
+	seed *int,
+	allocation *model.TestAllocation,
+	counts []*model.QualificationQuestionCountInput,
+) *question.GenerateTestConfig {
+	cfg := &question.GenerateTestConfig{
+		Qualifications: qualificationIDs,
+		Limit:          safeptr.SafeIntPointer(limit, question.TestMaxLimit),
+		Seed:           safeptr.SafeIntPointer(seed, 0),
+	}
+	if allocation != nil {
+		cfg.Allocation = *allocation
+	}
+	if len(counts) > 0 {
+		cfg.Counts = make(map[int]int, len(counts))
+		for _, count := range counts {
+			cfg.Counts[count.QualificationID] += count.Count
+		}
+	}
+	return cfg
+}
@@ -1,11 +1,18 @@
 package resolvers
 
 import (
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/auth"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/profession"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/session"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/statistics"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/user"
 )
 
@@ -19,14 +26,37 @@ type Resolver struct {
 	ProfessionUsecase    profession.Usecase
 	QualificationUsecase qualification.Usecase
 	QuestionUsecase      question.Usecase
+	SessionUsecase       session.Usecase
+	LoginLimiterUsecase  loginlimiter.Usecase
+	TestAttemptUsecase   testattempt.Usecase
+	StatisticsUsecase    statistics.Usecase
+	ReviewUsecase        review.Usecase
+	SharedTestUsecase    sharedtest.Usecase
+	FileStorage          fstorage.FileStorage
 }
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type professionResolver struct{ *Resolver }
 type questionResolver struct{ *Resolver }
+type imageVariantResolver struct{ *Resolver }
+type sessionResolver struct{ *Resolver }
+type testAttemptResolver struct{ *Resolver }
+type qualificationStatisticsResolver struct{ *Resolver }
+type qualificationQuestionCountResolver struct{ *Resolver }
 
-func (r *Resolver) Mutation() generated.MutationResolver     { return &mutationResolver{r} }
-func (r *Resolver) Query() generated.QueryResolver           { return &queryResolver{r} }
-func (r *Resolver) Profession() generated.ProfessionResolver { return &professionResolver{r} }
-func (r *Resolver) Question() generated.QuestionResolver     { return &questionResolver{r} }
+func (r *Resolver) Mutation() generated.MutationResolver       { return &mutationResolver{r} }
+func (r *Resolver) Query() generated.QueryResolver             { return &queryResolver{r} }
+func (r *Resolver) Profession() generated.ProfessionResolver   { return &professionResolver{r} }
+func (r *Resolver) Question() generated.QuestionResolver       { return &questionResolver{r} }
+func (r *Resolver) Session() generated.SessionResolver         { return &sessionResolver{r} }
+func (r *Resolver) TestAttempt() generated.TestAttemptResolver { return &testAttemptResolver{r} }
+func (r *Resolver) ImageVariant() generated.ImageVariantResolver {
+	return &imageVariantResolver{r}
+}
+func (r *Resolver) QualificationStatistics() generated.QualificationStatisticsResolver {
+	return &qualificationStatisticsResolver{r}
+}
+func (r *Resolver) QualificationQuestionCount() generated.QualificationQuestionCountResolver {
+	return &qualificationQuestionCountResolver{r}
+}
@@ -0,0 +1,56 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"github.com/Kichiyaki/goutil/safeptr"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
+)
+
+func (r *mutationResolver) ShareTest(ctx context.Context, input model.SharedTestInput) (*model.SharedTest, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	input.CreatedByID = u.ID
+	return r.SharedTestUsecase.Store(ctx, &input)
+}
+
+func (r *mutationResolver) StartSharedTest(
+	ctx context.Context,
+	code string,
+	shuffleAnswers *bool,
+) (*model.TestAttempt, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := r.SharedTestUsecase.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if t.Exam {
+		return r.TestAttemptUsecase.StartExam(ctx, &testattempt.StartExamConfig{
+			UserID:          u.ID,
+			QualificationID: t.QualificationIDs[0],
+			Seed:            t.Seed,
+			ShuffleAnswers:  safeptr.SafeBoolPointer(shuffleAnswers, false),
+		})
+	}
+	return r.TestAttemptUsecase.Start(ctx, &testattempt.StartConfig{
+		UserID:         u.ID,
+		Qualifications: t.QualificationIDs,
+		Limit:          t.Limit,
+		Seed:           t.Seed,
+		ShuffleAnswers: safeptr.SafeBoolPointer(shuffleAnswers, false),
+	})
+}
+
+func (r *queryResolver) SharedTest(ctx context.Context, code string) (*model.SharedTest, error) {
+	return r.SharedTestUsecase.GetByCode(ctx, code)
+}
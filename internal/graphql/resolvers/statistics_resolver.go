@@ -0,0 +1,48 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"github.com/Kichiyaki/goutil/safeptr"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/statistics"
+)
+
+func (r *qualificationStatisticsResolver) Qualification(
+	ctx context.Context,
+	obj *model.QualificationStatistics,
+) (*model.Qualification, error) {
+	if obj != nil && obj.Qualification != nil {
+		return obj.Qualification, nil
+	}
+
+	if obj != nil && obj.QualificationID > 0 {
+		if dataloader, err := middleware.DataLoaderFromContext(ctx); err == nil && dataloader != nil {
+			return dataloader.QualificationByID.Load(obj.QualificationID)
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) MyStatistics(ctx context.Context, days *int) (*model.UserStatistics, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.StatisticsUsecase.Get(ctx, &statistics.GetConfig{
+		UserID: u.ID,
+		Days:   safeptr.SafeIntPointer(days, statistics.DefaultDays),
+	})
+}
+
+func (r *queryResolver) UserStatistics(ctx context.Context, userID int, days *int) (*model.UserStatistics, error) {
+	return r.StatisticsUsecase.Get(ctx, &statistics.GetConfig{
+		UserID: userID,
+		Days:   safeptr.SafeIntPointer(days, statistics.DefaultDays),
+	})
+}
@@ -0,0 +1,152 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"github.com/Kichiyaki/goutil/safeptr"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/chi/middleware"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/graphql/generated"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
+)
+
+func (r *mutationResolver) StartTest(
+	ctx context.Context,
+	qualificationIDs []int,
+	limit *int,
+	seed *int,
+	shuffleAnswers *bool,
+) (*model.TestAttempt, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.TestAttemptUsecase.Start(ctx, &testattempt.StartConfig{
+		UserID:         u.ID,
+		Qualifications: qualificationIDs,
+		Limit:          safeptr.SafeIntPointer(limit, question.TestMaxLimit),
+		Seed:           safeptr.SafeIntPointer(seed, 0),
+		ShuffleAnswers: safeptr.SafeBoolPointer(shuffleAnswers, false),
+	})
+}
+
+func (r *mutationResolver) StartExam(
+	ctx context.Context,
+	qualificationID int,
+	seed *int,
+	shuffleAnswers *bool,
+) (*model.TestAttempt, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.TestAttemptUsecase.StartExam(ctx, &testattempt.StartExamConfig{
+		UserID:          u.ID,
+		QualificationID: qualificationID,
+		Seed:            safeptr.SafeIntPointer(seed, 0),
+		ShuffleAnswers:  safeptr.SafeBoolPointer(shuffleAnswers, false),
+	})
+}
+
+func (r *mutationResolver) SubmitTest(
+	ctx context.Context,
+	id int,
+	answers []*model.TestAttemptAnswerInput,
+) (*model.TestAttempt, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.TestAttemptUsecase.Submit(ctx, u.ID, id, answers)
+}
+
+func (r *queryResolver) MyTestAttempts(
+	ctx context.Context,
+	filter *model.TestAttemptFilter,
+	limit *int,
+	offset *int,
+	sort []string,
+) (*generated.TestAttemptList, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		filter = &model.TestAttemptFilter{}
+	}
+	filter.UserID = []int{u.ID}
+
+	list := &generated.TestAttemptList{}
+	list.Items, list.Total, err = r.TestAttemptUsecase.Fetch(
+		ctx,
+		&testattempt.FetchConfig{
+			Count:       shouldCount(ctx),
+			Filter:      filter,
+			Limit:       safeptr.SafeIntPointer(limit, testattempt.FetchDefaultLimit),
+			Offset:      safeptr.SafeIntPointer(offset, 0),
+			Sort:        sort,
+			WithAnswers: true,
+		},
+	)
+	return list, err
+}
+
+func (r *queryResolver) TestAttempt(ctx context.Context, id int) (*model.TestAttempt, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attempt, err := r.TestAttemptUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.UserID != u.ID && u.Role != model.RoleAdmin {
+		return nil, nil
+	}
+	return attempt, nil
+}
+
+func (r *testAttemptResolver) Questions(ctx context.Context, obj *model.TestAttempt) ([]*model.Question, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Questions != nil {
+		return revealAnswersIfSubmitted(obj, obj.PermuteQuestions(obj.Questions)), nil
+	}
+	if len(obj.QuestionIDs) == 0 {
+		return []*model.Question{}, nil
+	}
+
+	questionsNotInOrder, _, err := r.QuestionUsecase.Fetch(ctx, &question.FetchConfig{
+		Count: false,
+		Limit: len(obj.QuestionIDs),
+		Filter: &model.QuestionFilter{
+			ID: obj.QuestionIDs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	questionByID := make(map[int]*model.Question, len(questionsNotInOrder))
+	for _, q := range questionsNotInOrder {
+		questionByID[q.ID] = q
+	}
+	questions := make([]*model.Question, 0, len(obj.QuestionIDs))
+	for _, id := range obj.QuestionIDs {
+		if q, ok := questionByID[id]; ok {
+			questions = append(questions, q)
+		}
+	}
+	return revealAnswersIfSubmitted(obj, obj.PermuteQuestions(questions)), nil
+}
+
+func (r *testAttemptResolver) Answers(ctx context.Context, obj *model.TestAttempt) ([]*model.TestAttemptAnswer, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	return obj.DisplayedAnswers(), nil
+}
@@ -18,17 +18,53 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.UserInput
 }
 
 func (r *mutationResolver) UpdateUser(ctx context.Context, id int, input model.UserInput) (*model.User, error) {
-	return r.UserUsecase.UpdateOneByID(ctx, id, &input)
+	u, err := r.UserUsecase.UpdateOneByID(ctx, id, &input)
+	if err != nil {
+		return nil, err
+	}
+	if input.Activated != nil && !*input.Activated {
+		_, err = r.SessionUsecase.DeleteByUserID(ctx, u.ID)
+	} else if input.Password != nil {
+		_, err = r.SessionUsecase.DeleteByUserID(ctx, u.ID, currentSessionIDs(ctx)...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
 }
 
 func (r *mutationResolver) UpdateManyUsers(ctx context.Context, ids []int, input model.UserInput) ([]*model.User, error) {
-	return r.UserUsecase.UpdateMany(
+	users, err := r.UserUsecase.UpdateMany(
 		ctx,
 		&model.UserFilter{
 			ID: ids,
 		},
 		&input,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return users, nil
+	}
+	userIDs := make([]int, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+	if input.Activated != nil && !*input.Activated {
+		_, err = r.SessionUsecase.Delete(ctx, &model.SessionFilter{
+			UserID: userIDs,
+		})
+	} else if input.Password != nil {
+		_, err = r.SessionUsecase.Delete(ctx, &model.SessionFilter{
+			UserID: userIDs,
+			IDNEQ:  currentSessionIDs(ctx),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
 }
 
 func (r *mutationResolver) DeleteUsers(ctx context.Context, ids []int) ([]*model.User, error) {
@@ -37,24 +73,116 @@ func (r *mutationResolver) DeleteUsers(ctx context.Context, ids []int) ([]*model
 	})
 }
 
+func (r *mutationResolver) UpdateMe(ctx context.Context, input model.UserInput) (*model.User, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.UserUsecase.UpdateMe(ctx, u.ID, &input)
+}
+
+func (r *mutationResolver) ChangePassword(
+	ctx context.Context,
+	currentPassword string,
+	newPassword string,
+) (*model.User, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err = r.UserUsecase.ChangePassword(ctx, u.ID, currentPassword, newPassword)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.SessionUsecase.DeleteByUserID(ctx, u.ID, currentSessionIDs(ctx)...); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 func (r *mutationResolver) SignIn(
 	ctx context.Context,
 	email string,
 	password string,
 	staySignedIn *bool,
 ) (*generated.UserWithToken, error) {
-	var err error
-	userWithToken := &generated.UserWithToken{}
-	userWithToken.User, userWithToken.Token, err = r.AuthUsecase.SignIn(
+	client, _ := middleware.ClientInfoFromContext(ctx)
+	u, tokens, err := r.AuthUsecase.SignIn(
 		ctx,
 		email,
 		password,
 		safeptr.SafeBoolPointer(staySignedIn, false),
+		client,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return userWithToken, nil
+	return newUserWithToken(u, tokens), nil
+}
+
+func (r *mutationResolver) SignUp(ctx context.Context, input model.UserInput) (*model.User, error) {
+	return r.AuthUsecase.SignUp(ctx, &input)
+}
+
+func (r *mutationResolver) ActivateAccount(ctx context.Context, token string) (*model.User, error) {
+	return r.AuthUsecase.ActivateAccount(ctx, token)
+}
+
+func (r *mutationResolver) ResendActivationEmail(ctx context.Context, email string) (bool, error) {
+	if err := r.AuthUsecase.ResendActivationEmail(ctx, email); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, email string) (bool, error) {
+	if err := r.AuthUsecase.RequestPasswordReset(ctx, email); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ResetPassword(ctx context.Context, token string, newPassword string) (bool, error) {
+	if err := r.AuthUsecase.ResetPassword(ctx, token, newPassword); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) RefreshToken(ctx context.Context, refreshToken string) (*generated.UserWithToken, error) {
+	u, tokens, err := r.AuthUsecase.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return newUserWithToken(u, tokens), nil
+}
+
+func (r *mutationResolver) SignOut(ctx context.Context) (bool, error) {
+	sess, err := middleware.SessionFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, err := r.SessionUsecase.Delete(ctx, &model.SessionFilter{
+		ID: []string{sess.ID},
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) SignOutEverywhere(ctx context.Context) (bool, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, err := r.SessionUsecase.DeleteByUserID(ctx, u.ID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) RevokeUserSessions(ctx context.Context, userID int) ([]*model.Session, error) {
+	return r.SessionUsecase.DeleteByUserID(ctx, userID)
 }
 
 func (r *queryResolver) Users(
@@ -87,3 +215,19 @@ func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
 	u, _ := middleware.UserFromContext(ctx)
 	return u, nil
 }
+
+func (r *queryResolver) MySessions(ctx context.Context) ([]*model.Session, error) {
+	u, err := middleware.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.SessionUsecase.FetchActiveByUserID(ctx, u.ID)
+}
+
+func (r *sessionResolver) Current(ctx context.Context, obj *model.Session) (bool, error) {
+	sess, err := middleware.SessionFromContext(ctx)
+	if err != nil || obj == nil {
+		return false, nil
+	}
+	return sess.ID == obj.ID, nil
+}
@@ -0,0 +1,244 @@
+// Package imageproc normalizes the uploaded images: it checks the real format of the file, applies the EXIF orientation,
+// limits the size, re-encodes the image to WebP (which drops all the metadata) and generates the downscaled variants.
+package imageproc
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/chai2010/webp"
+	"github.com/pkg/errors"
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	DefaultMaxDimension = 2048
+	DefaultMaxPixels    = 50000000
+	DefaultQuality      = 80
+
+	// ContentType and Extension describe the processed images.
+	ContentType = "image/webp"
+	Extension   = ".webp"
+
+	sniffLen = 512
+)
+
+// DefaultVariantWidths fit a thumbnail, a phone and a tablet screen.
+var DefaultVariantWidths = []int{160, 480, 960}
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooLarge          = errors.New("the image is too large")
+)
+
+var supportedContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+type Config struct {
+	// MaxDimension is the maximum width and height of the processed image, the bigger images are downscaled.
+	MaxDimension int
+	// MaxPixels protects against the decompression bombs, the images with more pixels are rejected before decoding.
+	MaxPixels int
+	// Quality of the lossy WebP encoding (0-100).
+	Quality int
+	// VariantWidths are the widths of the generated variants, a variant is generated only if it's smaller than the image.
+	VariantWidths []int
+}
+
+type Processor struct {
+	maxDimension  int
+	maxPixels     int
+	quality       float32
+	variantWidths []int
+}
+
+func New(cfg *Config) *Processor {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	p := &Processor{
+		maxDimension:  cfg.MaxDimension,
+		maxPixels:     cfg.MaxPixels,
+		quality:       float32(cfg.Quality),
+		variantWidths: append([]int(nil), cfg.VariantWidths...),
+	}
+	if p.maxDimension <= 0 {
+		p.maxDimension = DefaultMaxDimension
+	}
+	if p.maxPixels <= 0 {
+		p.maxPixels = DefaultMaxPixels
+	}
+	if p.quality <= 0 || p.quality > 100 {
+		p.quality = DefaultQuality
+	}
+	if cfg.VariantWidths == nil {
+		p.variantWidths = append(p.variantWidths, DefaultVariantWidths...)
+	}
+	sort.Ints(p.variantWidths)
+	return p
+}
+
+// Sniff detects the content type from the first bytes of the file,
+// the returned reader yields the whole file (including the sniffed bytes).
+func Sniff(r io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", nil, errors.Wrap(err, "couldn't read the file")
+	}
+	head = head[:n]
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), r), nil
+}
+
+func IsSupported(contentType string) bool {
+	return supportedContentTypes[contentType]
+}
+
+// Validate checks the format and the size of the image without decoding it,
+// the returned reader yields the whole image and should be used instead of r.
+func (p *Processor) Validate(r io.Reader) (io.Reader, error) {
+	contentType, r, err := Sniff(r)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSupported(contentType) {
+		return nil, ErrUnsupportedFormat
+	}
+	// DecodeConfig reads only the header, the read bytes are kept to restore the image
+	head := &bytes.Buffer{}
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, head))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if !p.isSizeAllowed(cfg) {
+		return nil, ErrTooLarge
+	}
+	return io.MultiReader(head, r), nil
+}
+
+type Image struct {
+	Data   []byte
+	Width  int
+	Height int
+}
+
+type Result struct {
+	Image *Image
+	// Variants are sorted by width, ascending.
+	Variants []*Image
+}
+
+func (p *Processor) Process(r io.Reader) (*Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read the image")
+	}
+	if !IsSupported(http.DetectContentType(data)) {
+		return nil, ErrUnsupportedFormat
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if !p.isSizeAllowed(cfg) {
+		return nil, ErrTooLarge
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decode the image")
+	}
+
+	img := toNRGBA(decoded)
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	img = fit(img, p.maxDimension)
+
+	result := &Result{}
+	// the drawings and the screenshots (png, gif) compress better and stay sharp without loss
+	lossless := format == "png" || format == "gif"
+	result.Image, err = p.encode(img, lossless)
+	if err != nil {
+		return nil, err
+	}
+	for _, width := range p.variantWidths {
+		if width >= img.Rect.Dx() {
+			break
+		}
+		variant, err := p.encode(resize(img, width, scaledHeight(img, width)), false)
+		if err != nil {
+			return nil, err
+		}
+		result.Variants = append(result.Variants, variant)
+	}
+	return result, nil
+}
+
+func (p *Processor) isSizeAllowed(cfg image.Config) bool {
+	return cfg.Width > 0 && cfg.Height > 0 && cfg.Width*cfg.Height <= p.maxPixels
+}
+
+func (p *Processor) encode(img *image.NRGBA, lossless bool) (*Image, error) {
+	buf := &bytes.Buffer{}
+	// libwebp expects the non-premultiplied colors, the pixels of image.NRGBA are passed as they are
+	err := webp.Encode(buf, &image.RGBA{Pix: img.Pix, Stride: img.Stride, Rect: img.Rect}, &webp.Options{
+		Lossless: lossless,
+		Quality:  p.quality,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't encode the image")
+	}
+	return &Image{
+		Data:   buf.Bytes(),
+		Width:  img.Rect.Dx(),
+		Height: img.Rect.Dy(),
+	}, nil
+}
+
+// toNRGBA converts the image to image.NRGBA with the origin at (0, 0).
+func toNRGBA(src image.Image) *image.NRGBA {
+	b := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, src, b.Min, draw.Src)
+	return dst
+}
+
+// fit downscales the image, so that neither of its dimensions exceeds max.
+func fit(img *image.NRGBA, max int) *image.NRGBA {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	if w <= max && h <= max {
+		return img
+	}
+	if w >= h {
+		return resize(img, max, scaledHeight(img, max))
+	}
+	return resize(img, maxInt(1, w*max/h), max)
+}
+
+func scaledHeight(img *image.NRGBA, width int) int {
+	return maxInt(1, img.Rect.Dy()*width/img.Rect.Dx())
+}
+
+func resize(img *image.NRGBA, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xdraw.CatmullRom.Scale(dst, dst.Rect, img, img.Rect, xdraw.Src, nil)
+	return dst
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
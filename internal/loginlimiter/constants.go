@@ -0,0 +1,38 @@
+package loginlimiter
+
+import "time"
+
+const (
+	FetchMaxLimit = 100
+	MaxOrders     = 3
+
+	KeyPrefixEmail = "email:"
+	KeyPrefixIP    = "ip:"
+
+	// DefaultResetAfter is the period of time after which the failure counter of a key starts from scratch.
+	DefaultResetAfter = time.Hour
+	// PruneInterval is the minimum period of time between two prunings of the stale lockouts.
+	PruneInterval = time.Minute
+)
+
+var (
+	DefaultEmailPolicy = Policy{
+		FreeAttempts: 5,
+		BaseLockout:  30 * time.Second,
+		MaxLockout:   time.Hour,
+	}
+	// DefaultIPPolicy is more lenient than DefaultEmailPolicy, as many users may share the same IP address (e.g. a school network).
+	DefaultIPPolicy = Policy{
+		FreeAttempts: 20,
+		BaseLockout:  30 * time.Second,
+		MaxLockout:   time.Hour,
+	}
+)
+
+func EmailKey(email string) string {
+	return KeyPrefixEmail + email
+}
+
+func IPKey(ip string) string {
+	return KeyPrefixIP + ip
+}
@@ -0,0 +1,31 @@
+package loginlimiter
+
+import "time"
+
+// Policy describes how long a key is locked after a failed sign-in attempt.
+// The first FreeAttempts failures don't lock the key, each next one locks it for BaseLockout,
+// doubled with every subsequent failure, but never longer than MaxLockout.
+type Policy struct {
+	FreeAttempts int
+	BaseLockout  time.Duration
+	MaxLockout   time.Duration
+}
+
+// LockoutDuration returns 0 if the given number of failures doesn't lock the key.
+func (p Policy) LockoutDuration(failures int) time.Duration {
+	exceeded := failures - p.FreeAttempts
+	if exceeded <= 0 || p.BaseLockout <= 0 {
+		return 0
+	}
+	d := p.BaseLockout
+	for i := 1; i < exceeded; i++ {
+		d *= 2
+		if p.MaxLockout > 0 && d >= p.MaxLockout {
+			return p.MaxLockout
+		}
+	}
+	if p.MaxLockout > 0 && d > p.MaxLockout {
+		return p.MaxLockout
+	}
+	return d
+}
@@ -0,0 +1,28 @@
+package loginlimiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchConfig struct {
+	Filter *model.LoginLockoutFilter
+	Offset int
+	Limit  int
+	Sort   []string
+	Count  bool
+}
+
+type Repository interface {
+	// RegisterFailure increments the failure counter of the given key and returns the updated record.
+	// The counter starts from scratch if the last failure happened before resetBefore.
+	RegisterFailure(ctx context.Context, key string, resetBefore time.Time) (*model.LoginLockout, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Delete(ctx context.Context, f *model.LoginLockoutFilter) ([]*model.LoginLockout, error)
+	// Prune deletes the lockouts that have expired (or have never been set) and whose last failure happened before resetBefore,
+	// they don't affect the next sign-in attempts anymore.
+	Prune(ctx context.Context, resetBefore time.Time) error
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.LoginLockout, int, error)
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+// MemoryRepository keeps the lockouts in the memory of a single instance of the application.
+// Sorting isn't supported, the lockouts are always ordered by the last failure (descending).
+type MemoryRepository struct {
+	mu           sync.Mutex
+	lockouts     map[string]*model.LoginLockout
+	lastPrunedAt time.Time
+}
+
+var _ loginlimiter.Repository = &MemoryRepository{}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		lockouts: make(map[string]*model.LoginLockout),
+	}
+}
+
+func (repo *MemoryRepository) RegisterFailure(
+	ctx context.Context,
+	key string,
+	resetBefore time.Time,
+) (*model.LoginLockout, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(repo.lastPrunedAt) >= loginlimiter.PruneInterval {
+		repo.prune(now, resetBefore)
+	}
+
+	item, ok := repo.lockouts[key]
+	if !ok {
+		item = &model.LoginLockout{
+			Key: key,
+		}
+		repo.lockouts[key] = item
+	}
+	if item.LastFailureAt.Before(resetBefore) {
+		item.Failures = 0
+	}
+	item.Failures++
+	item.LastFailureAt = now
+
+	return copyLockout(item), nil
+}
+
+func (repo *MemoryRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if item, ok := repo.lockouts[key]; ok {
+		item.LockedUntil = &until
+	}
+	return nil
+}
+
+func (repo *MemoryRepository) Delete(ctx context.Context, f *model.LoginLockoutFilter) ([]*model.LoginLockout, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	items := repo.filter(f)
+	for _, item := range items {
+		delete(repo.lockouts, item.Key)
+	}
+	return items, nil
+}
+
+func (repo *MemoryRepository) Prune(ctx context.Context, resetBefore time.Time) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	repo.prune(time.Now(), resetBefore)
+	return nil
+}
+
+func (repo *MemoryRepository) Fetch(ctx context.Context, cfg *loginlimiter.FetchConfig) ([]*model.LoginLockout, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	items := repo.filter(cfg.Filter)
+	total := len(items)
+	if cfg.Offset >= len(items) {
+		items = items[:0]
+	} else {
+		items = items[cfg.Offset:]
+	}
+	if cfg.Limit > 0 && cfg.Limit < len(items) {
+		items = items[:cfg.Limit]
+	}
+	if !cfg.Count {
+		total = 0
+	}
+	return items, total, nil
+}
+
+func (repo *MemoryRepository) filter(f *model.LoginLockoutFilter) []*model.LoginLockout {
+	items := make([]*model.LoginLockout, 0)
+	for _, item := range repo.lockouts {
+		if matches(f, item) {
+			items = append(items, copyLockout(item))
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastFailureAt.After(items[j].LastFailureAt)
+	})
+	return items
+}
+
+// prune removes the lockouts that are neither active nor counting failures anymore,
+// so that the map doesn't grow indefinitely.
+func (repo *MemoryRepository) prune(now, resetBefore time.Time) {
+	repo.lastPrunedAt = now
+	for key, item := range repo.lockouts {
+		if item.LastFailureAt.Before(resetBefore) && (item.LockedUntil == nil || item.LockedUntil.Before(now)) {
+			delete(repo.lockouts, key)
+		}
+	}
+}
+
+func matches(f *model.LoginLockoutFilter, item *model.LoginLockout) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Key) > 0 {
+		found := false
+		for _, key := range f.Key {
+			if key == item.Key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !f.LockedUntilGT.IsZero() && (item.LockedUntil == nil || !item.LockedUntil.After(f.LockedUntilGT)) {
+		return false
+	}
+	return true
+}
+
+func copyLockout(item *model.LoginLockout) *model.LoginLockout {
+	cp := *item
+	if item.LockedUntil != nil {
+		lockedUntil := *item.LockedUntil
+		cp.LockedUntil = &lockedUntil
+	}
+	return &cp
+}
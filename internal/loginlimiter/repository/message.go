@@ -0,0 +1,7 @@
+package repository
+
+const (
+	messageFailedToSaveModel   = "Wystąpił błąd podczas zapisywania blokady logowania."
+	messageFailedToDeleteModel = "Wystąpił błąd podczas usuwania blokady logowania."
+	messageFailedToFetchModel  = "Wystąpił błąd podczas pobierania blokad logowania."
+)
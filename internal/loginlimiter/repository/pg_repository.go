@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+// PGRepository shares the lockouts between all instances of the application.
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ loginlimiter.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) RegisterFailure(
+	ctx context.Context,
+	key string,
+	resetBefore time.Time,
+) (*model.LoginLockout, error) {
+	item := &model.LoginLockout{
+		Key:           key,
+		Failures:      1,
+		LastFailureAt: time.Now(),
+	}
+	if _, err := repo.
+		Model(item).
+		Context(ctx).
+		OnConflict("(key) DO UPDATE").
+		Set(
+			"failures = CASE WHEN ?TableAlias.last_failure_at < ? THEN 1 ELSE ?TableAlias.failures + 1 END",
+			resetBefore,
+		).
+		Set("last_failure_at = EXCLUDED.last_failure_at").
+		Returning("*").
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	if _, err := repo.
+		Model(&model.LoginLockout{}).
+		Context(ctx).
+		Set("locked_until = ?", until).
+		Where(gopgutil.BuildConditionEquals("key"), key).
+		Update(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Delete(ctx context.Context, f *model.LoginLockoutFilter) ([]*model.LoginLockout, error) {
+	items := make([]*model.LoginLockout, 0)
+	if _, err := repo.
+		Model(&items).
+		Context(ctx).
+		Returning("*").
+		Apply(f.Where).
+		Delete(); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToDeleteModel)
+	}
+	return items, nil
+}
+
+func (repo *PGRepository) Prune(ctx context.Context, resetBefore time.Time) error {
+	if _, err := repo.
+		Model(&model.LoginLockout{}).
+		Context(ctx).
+		Where(gopgutil.BuildConditionLT("?"), gopgutil.AddAliasToColumnName("last_failure_at", "login_lockout"), resetBefore).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.
+				WhereOr("? IS NULL", gopgutil.AddAliasToColumnName("locked_until", "login_lockout")).
+				WhereOr(gopgutil.BuildConditionLTE("?"), gopgutil.AddAliasToColumnName("locked_until", "login_lockout"), time.Now()), nil
+		}).
+		Delete(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToDeleteModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Fetch(ctx context.Context, cfg *loginlimiter.FetchConfig) ([]*model.LoginLockout, int, error) {
+	var err error
+	items := make([]*model.LoginLockout, 0)
+	total := 0
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Limit(cfg.Limit).
+		Offset(cfg.Offset).
+		Apply(cfg.Filter.Where).
+		Apply(gopgutil.OrderAppender{
+			Orders: cfg.Sort,
+		}.Apply)
+
+	if cfg.Count {
+		total, err = query.SelectAndCount()
+	} else {
+		err = query.Select()
+	}
+	if err != nil && err != pg.ErrNoRows {
+		return nil, 0, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, total, nil
+}
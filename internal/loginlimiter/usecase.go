@@ -0,0 +1,16 @@
+package loginlimiter
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Usecase interface {
+	// Check returns an error if the e-mail or the IP address is locked.
+	Check(ctx context.Context, email, ip string) error
+	RegisterFailure(ctx context.Context, email, ip string) error
+	RegisterSuccess(ctx context.Context, email string) error
+	Delete(ctx context.Context, f *model.LoginLockoutFilter) ([]*model.LoginLockout, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.LoginLockout, int, error)
+}
@@ -0,0 +1,5 @@
+package usecase
+
+const (
+	messageTooManyFailedAttempts = "Zbyt wiele nieudanych prób logowania. Spróbuj ponownie za %s."
+)
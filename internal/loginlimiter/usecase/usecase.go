@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/loginlimiter"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+var log = logrus.WithField("package", "internal/loginlimiter/usecase")
+
+type Config struct {
+	LoginLimiterRepository loginlimiter.Repository
+	// EmailPolicy defaults to loginlimiter.DefaultEmailPolicy.
+	EmailPolicy *loginlimiter.Policy
+	// IPPolicy defaults to loginlimiter.DefaultIPPolicy.
+	IPPolicy *loginlimiter.Policy
+	// ResetAfter defaults to loginlimiter.DefaultResetAfter.
+	ResetAfter time.Duration
+}
+
+type Usecase struct {
+	loginLimiterRepository loginlimiter.Repository
+	emailPolicy            loginlimiter.Policy
+	ipPolicy               loginlimiter.Policy
+	resetAfter             time.Duration
+
+	mu           sync.Mutex
+	lastPrunedAt time.Time
+}
+
+var _ loginlimiter.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.LoginLimiterRepository == nil {
+		return nil, errors.New("cfg.LoginLimiterRepository is required")
+	}
+	ucase := &Usecase{
+		loginLimiterRepository: cfg.LoginLimiterRepository,
+		emailPolicy:            loginlimiter.DefaultEmailPolicy,
+		ipPolicy:               loginlimiter.DefaultIPPolicy,
+		resetAfter:             cfg.ResetAfter,
+	}
+	if cfg.EmailPolicy != nil {
+		ucase.emailPolicy = *cfg.EmailPolicy
+	}
+	if cfg.IPPolicy != nil {
+		ucase.ipPolicy = *cfg.IPPolicy
+	}
+	if ucase.resetAfter <= 0 {
+		ucase.resetAfter = loginlimiter.DefaultResetAfter
+	}
+	return ucase, nil
+}
+
+func (ucase *Usecase) Check(ctx context.Context, email, ip string) error {
+	keys := buildKeys(email, ip)
+	if len(keys) == 0 {
+		return nil
+	}
+	items, _, err := ucase.loginLimiterRepository.Fetch(ctx, &loginlimiter.FetchConfig{
+		Count: false,
+		Limit: len(keys),
+		Filter: &model.LoginLockoutFilter{
+			Key:           keys,
+			LockedUntilGT: time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var lockedUntil time.Time
+	for _, item := range items {
+		if item.IsLocked() && item.LockedUntil.After(lockedUntil) {
+			lockedUntil = *item.LockedUntil
+		}
+	}
+	if lockedUntil.IsZero() {
+		return nil
+	}
+
+	retryAfter := time.Until(lockedUntil).Truncate(time.Second) + time.Second
+	return errors.Errorf(messageTooManyFailedAttempts, retryAfter.String())
+}
+
+func (ucase *Usecase) RegisterFailure(ctx context.Context, email, ip string) error {
+	resetBefore := time.Now().Add(-ucase.resetAfter)
+	for _, key := range buildKeys(email, ip) {
+		item, err := ucase.loginLimiterRepository.RegisterFailure(ctx, key, resetBefore)
+		if err != nil {
+			return err
+		}
+
+		policy := ucase.emailPolicy
+		if strings.HasPrefix(key, loginlimiter.KeyPrefixIP) {
+			policy = ucase.ipPolicy
+		}
+		if d := policy.LockoutDuration(item.Failures); d > 0 {
+			if err := ucase.loginLimiterRepository.Lock(ctx, key, item.LastFailureAt.Add(d)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RegisterSuccess resets the failure counter of the given e-mail and prunes the stale lockouts of the other keys.
+// The counter of the IP address is left untouched, otherwise an attacker could reset it with their own account.
+func (ucase *Usecase) RegisterSuccess(ctx context.Context, email string) error {
+	keys := buildKeys(email, "")
+	if len(keys) == 0 {
+		return nil
+	}
+	if _, err := ucase.loginLimiterRepository.Delete(ctx, &model.LoginLockoutFilter{
+		Key: keys,
+	}); err != nil {
+		return err
+	}
+	ucase.prune(ctx)
+	return nil
+}
+
+// prune deletes the stale lockouts at most once per loginlimiter.PruneInterval,
+// a failure is only logged, it shouldn't prevent the user from signing in.
+func (ucase *Usecase) prune(ctx context.Context) {
+	now := time.Now()
+	ucase.mu.Lock()
+	if now.Sub(ucase.lastPrunedAt) < loginlimiter.PruneInterval {
+		ucase.mu.Unlock()
+		return
+	}
+	ucase.lastPrunedAt = now
+	ucase.mu.Unlock()
+
+	if err := ucase.loginLimiterRepository.Prune(ctx, now.Add(-ucase.resetAfter)); err != nil {
+		log.WithError(err).Warn("Couldn't prune the login lockouts")
+	}
+}
+
+func (ucase *Usecase) Delete(ctx context.Context, f *model.LoginLockoutFilter) ([]*model.LoginLockout, error) {
+	if f == nil {
+		return []*model.LoginLockout{}, nil
+	}
+	return ucase.loginLimiterRepository.Delete(ctx, f)
+}
+
+func (ucase *Usecase) Fetch(ctx context.Context, cfg *loginlimiter.FetchConfig) ([]*model.LoginLockout, int, error) {
+	if cfg == nil {
+		cfg = &loginlimiter.FetchConfig{
+			Limit: loginlimiter.FetchMaxLimit,
+			Count: true,
+		}
+	}
+	if cfg.Limit > loginlimiter.FetchMaxLimit || cfg.Limit <= 0 {
+		cfg.Limit = loginlimiter.FetchMaxLimit
+	}
+	if len(cfg.Sort) > loginlimiter.MaxOrders {
+		cfg.Sort = cfg.Sort[0:loginlimiter.MaxOrders]
+	}
+	return ucase.loginLimiterRepository.Fetch(ctx, cfg)
+}
+
+func buildKeys(email, ip string) []string {
+	var keys []string
+	if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+		keys = append(keys, loginlimiter.EmailKey(email))
+	}
+	if ip = strings.TrimSpace(ip); ip != "" {
+		keys = append(keys, loginlimiter.IPKey(ip))
+	}
+	return keys
+}
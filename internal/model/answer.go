@@ -48,3 +48,62 @@ func (answer *Answer) UnmarshalGQL(v interface{}) error {
 func (answer Answer) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(answer.String()))
 }
+
+func (answer Answer) index() int {
+	switch answer {
+	case AnswerA:
+		return 0
+	case AnswerB:
+		return 1
+	case AnswerC:
+		return 2
+	case AnswerD:
+		return 3
+	}
+	return -1
+}
+
+// AnswerPermutation describes the order in which the answers of a question are displayed,
+// the option displayed as the i-th one is the stored answer AnswerPermutation[i].
+type AnswerPermutation [4]Answer
+
+func NewIdentityAnswerPermutation() AnswerPermutation {
+	return AnswerPermutation{AnswerA, AnswerB, AnswerC, AnswerD}
+}
+
+// NewAnswerPermutation returns a permutation determined by the given key (the same key gives the same permutation).
+func NewAnswerPermutation(key uint64) AnswerPermutation {
+	p := NewIdentityAnswerPermutation()
+	for i := len(p) - 1; i > 0; i-- {
+		key = mix64(key)
+		j := int(key % uint64(i+1))
+		p[i], p[j] = p[j], p[i]
+	}
+	return p
+}
+
+// ToStored translates the answer chosen by the user to the answer the question is stored with.
+func (p AnswerPermutation) ToStored(displayed Answer) Answer {
+	if i := displayed.index(); i >= 0 {
+		return p[i]
+	}
+	return displayed
+}
+
+// ToDisplayed translates the stored answer to the one the user sees.
+func (p AnswerPermutation) ToDisplayed(stored Answer) Answer {
+	for i, answer := range p {
+		if answer == stored {
+			return NewIdentityAnswerPermutation()[i]
+		}
+	}
+	return stored
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(z uint64) uint64 {
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// FileDeletion is a file scheduled for deletion from the file storage (an outbox entry).
+// The deletions are recorded in the same transaction as the change that dereferences the file
+// and they're processed by the file deletion worker.
+type FileDeletion struct {
+	tableName struct{} `pg:"alias:file_deletion"`
+
+	ID       int    `json:"id" xml:"id" gqlgen:"id"`
+	Filename string `pg:",notnull" json:"filename" xml:"filename" gqlgen:"filename"`
+	// Attempts is incremented every time the deletion is claimed by the worker.
+	Attempts  int    `pg:"default:0,notnull" json:"attempts" xml:"attempts" gqlgen:"attempts"`
+	LastError string `json:"lastError" xml:"lastError" gqlgen:"lastError"`
+	// ProcessAfter postpones the deletion, e.g. until the next attempt or until the upload it guards is expected to finish.
+	ProcessAfter time.Time `pg:"default:now(),notnull" json:"processAfter" xml:"processAfter" gqlgen:"processAfter"`
+	CreatedAt    time.Time `pg:"default:now(),notnull" json:"createdAt" xml:"createdAt" gqlgen:"createdAt"`
+}
+
+func NewFileDeletions(filenames []string, processAfter time.Time) []*FileDeletion {
+	deletions := make([]*FileDeletion, len(filenames))
+	for i, filename := range filenames {
+		deletions[i] = &FileDeletion{
+			Filename:     filename,
+			ProcessAfter: processAfter,
+			CreatedAt:    time.Now(),
+		}
+	}
+	return deletions
+}
@@ -0,0 +1,8 @@
+package model
+
+// ImageVariant is a downscaled copy of an image, the variants let the clients on slow networks load smaller files.
+type ImageVariant struct {
+	Filename string `json:"filename" xml:"filename" gqlgen:"-"`
+	Width    int    `json:"width" xml:"width" gqlgen:"width"`
+	Height   int    `json:"height" xml:"height" gqlgen:"height"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/Kichiyaki/gopgutil/v10"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// LoginLockout keeps track of failed sign-in attempts for a single key (an e-mail or an IP address).
+type LoginLockout struct {
+	tableName struct{} `pg:"alias:login_lockout"`
+
+	Key           string     `json:"key" pg:",pk" xml:"key" gqlgen:"key"`
+	Failures      int        `json:"failures" pg:",use_zero,notnull" xml:"failures" gqlgen:"failures"`
+	LastFailureAt time.Time  `json:"lastFailureAt" pg:",notnull" xml:"lastFailureAt" gqlgen:"lastFailureAt"`
+	LockedUntil   *time.Time `json:"lockedUntil" xml:"lockedUntil" gqlgen:"lockedUntil"`
+}
+
+func (l *LoginLockout) IsLocked() bool {
+	return l.LockedUntil != nil && l.LockedUntil.After(time.Now())
+}
+
+type LoginLockoutFilter struct {
+	Key []string `json:"key" xml:"key" gqlgen:"key"`
+
+	LockedUntilGT time.Time `json:"lockedUntilGT" xml:"lockedUntilGT" gqlgen:"lockedUntilGT"`
+}
+
+func (f *LoginLockoutFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.Key) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("key", alias), pg.Array(f.Key))
+	}
+
+	if !isZero(f.LockedUntilGT) {
+		q = q.Where(gopgutil.BuildConditionGT("?"), gopgutil.AddAliasToColumnName("locked_until", alias), f.LockedUntilGT)
+	}
+
+	return q, nil
+}
+
+func (f *LoginLockoutFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "login_lockout")
+}
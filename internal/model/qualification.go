@@ -24,6 +24,9 @@ type Qualification struct {
 	Formula     string    `json:"formula" xml:"formula" gqlgen:"formula"`
 	Description string    `json:"description" xml:"description" gqlgen:"description"`
 	CreatedAt   time.Time `json:"createdAt,omitempty" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
+
+	ExamQuestionCount   int `json:"examQuestionCount" pg:",notnull,default:40" xml:"examQuestionCount" gqlgen:"examQuestionCount"`
+	ExamDurationMinutes int `json:"examDurationMinutes" pg:",notnull,default:60" xml:"examDurationMinutes" gqlgen:"examDurationMinutes"`
 }
 
 func (q *Qualification) BeforeInsert(ctx context.Context) (context.Context, error) {
@@ -54,6 +57,8 @@ type QualificationInput struct {
 	Description          *string `json:"description" xml:"description" gqlgen:"description"`
 	Code                 *string `json:"code" xml:"code" gqlgen:"code"`
 	Formula              *string `json:"formula" xml:"formula" gqlgen:"formula"`
+	ExamQuestionCount    *int    `json:"examQuestionCount" xml:"examQuestionCount" gqlgen:"examQuestionCount"`
+	ExamDurationMinutes  *int    `json:"examDurationMinutes" xml:"examDurationMinutes" gqlgen:"examDurationMinutes"`
 	AssociateProfession  []int   `json:"associateProfession" xml:"associateProfession" gqlgen:"associateProfession"`
 	DissociateProfession []int   `json:"dissociateProfession" xml:"dissociateProfession" gqlgen:"dissociateProfession"`
 }
@@ -64,6 +69,8 @@ func (input *QualificationInput) IsEmpty() bool {
 		input.Code == nil &&
 		input.Formula == nil &&
 		input.Description == nil &&
+		input.ExamQuestionCount == nil &&
+		input.ExamDurationMinutes == nil &&
 		len(input.AssociateProfession) == 0 &&
 		len(input.DissociateProfession) == 0
 }
@@ -73,7 +80,9 @@ func (input *QualificationInput) HasBasicDataToUpdate() bool {
 		(input.Name != nil ||
 			input.Code != nil ||
 			input.Formula != nil ||
-			input.Description != nil)
+			input.Description != nil ||
+			input.ExamQuestionCount != nil ||
+			input.ExamDurationMinutes != nil)
 }
 
 func (input *QualificationInput) Sanitize() *QualificationInput {
@@ -107,6 +116,12 @@ func (input *QualificationInput) ToQualification() *Qualification {
 	if input.Formula != nil {
 		q.Formula = *input.Formula
 	}
+	if input.ExamQuestionCount != nil {
+		q.ExamQuestionCount = *input.ExamQuestionCount
+	}
+	if input.ExamDurationMinutes != nil {
+		q.ExamDurationMinutes = *input.ExamDurationMinutes
+	}
 	return q
 }
 
@@ -124,6 +139,12 @@ func (input *QualificationInput) ApplyUpdate(q *orm.Query) (*orm.Query, error) {
 		if input.Description != nil {
 			q = q.Set(gopgutil.BuildConditionEquals("description"), *input.Description)
 		}
+		if input.ExamQuestionCount != nil {
+			q = q.Set(gopgutil.BuildConditionEquals("exam_question_count"), *input.ExamQuestionCount)
+		}
+		if input.ExamDurationMinutes != nil {
+			q = q.Set(gopgutil.BuildConditionEquals("exam_duration_minutes"), *input.ExamDurationMinutes)
+		}
 	}
 
 	return q, nil
@@ -34,6 +34,14 @@ type Question struct {
 	Qualification   *Qualification `pg:"rel:has-one" json:"qualification" xml:"qualification" gqlgen:"qualification"`
 	CreatedAt       time.Time      `json:"createdAt,omitempty" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
 	UpdatedAt       time.Time      `pg:"default:now()" json:"updatedAt" xml:"updatedAt" gqlgen:"updatedAt"`
+	// ImageVariants maps the filenames of the images to their variants (sorted by width, ascending).
+	ImageVariants map[string][]*ImageVariant `pg:",notnull,default:'{}'" json:"-" xml:"-" gqlgen:"-"`
+	// RandomKey is a random number from the range [0, 1) assigned on insert, it's used to sample questions without sorting the whole table.
+	RandomKey float64 `pg:",notnull,default:random()" json:"-" xml:"-" gqlgen:"-"`
+	// ChangeTxID is the ID of the last transaction that changed the question, it's set by a trigger.
+	ChangeTxID int64 `pg:"change_txid,notnull,default:txid_current()" json:"-" xml:"-" gqlgen:"-"`
+
+	answerRevealed bool
 }
 
 func (q *Question) BeforeInsert(ctx context.Context) (context.Context, error) {
@@ -43,6 +51,38 @@ func (q *Question) BeforeInsert(ctx context.Context) (context.Context, error) {
 	return ctx, nil
 }
 
+// VariantsOf returns the variants of the given image of the question.
+func (q *Question) VariantsOf(image string) []*ImageVariant {
+	if image == "" {
+		return nil
+	}
+	return q.ImageVariants[image]
+}
+
+// RevealAnswer allows non-admin users to see the correct answer and the explanation of the question
+// (e.g. after they've submitted the test the question is part of).
+func (q *Question) RevealAnswer() {
+	q.answerRevealed = true
+}
+
+func (q *Question) IsAnswerRevealed() bool {
+	return q.answerRevealed
+}
+
+// WithPermutedAnswers returns a copy of the question with the answers (and their images) displayed in the given order,
+// the correct answer of the copy points to the displayed option.
+func (q *Question) WithPermutedAnswers(p AnswerPermutation) *Question {
+	contents := [4]string{q.AnswerA, q.AnswerB, q.AnswerC, q.AnswerD}
+	images := [4]string{q.AnswerAImage, q.AnswerBImage, q.AnswerCImage, q.AnswerDImage}
+	permuted := *q
+	permuted.AnswerA, permuted.AnswerAImage = contents[p[0].index()], images[p[0].index()]
+	permuted.AnswerB, permuted.AnswerBImage = contents[p[1].index()], images[p[1].index()]
+	permuted.AnswerC, permuted.AnswerCImage = contents[p[2].index()], images[p[2].index()]
+	permuted.AnswerD, permuted.AnswerDImage = contents[p[3].index()], images[p[3].index()]
+	permuted.CorrectAnswer = p.ToDisplayed(q.CorrectAnswer)
+	return &permuted
+}
+
 type QuestionInput struct {
 	Content            *string         `json:"content" xml:"content" gqlgen:"content"`
 	From               *string         `json:"from" xml:"from" gqlgen:"from"`
@@ -212,6 +252,8 @@ type QuestionFilter struct {
 	CreatedAtGTE time.Time `json:"createdAtGTE" xml:"createdAtGTE" gqlgen:"createdAtGTE"`
 	CreatedAtLT  time.Time `gqlgen:"createdAtLT" json:"createdAtLT" xml:"createdAtLT"`
 	CreatedAtLTE time.Time `json:"createdAtLTE" xml:"createdAtLTE" gqlgen:"createdAtLTE"`
+
+	UpdatedAtGT time.Time `json:"updatedAtGT" xml:"updatedAtGT" gqlgen:"updatedAtGT"`
 }
 
 func (f *QuestionFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
@@ -268,9 +310,40 @@ func (f *QuestionFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query,
 		q = q.Where(gopgutil.BuildConditionLTE("?"), gopgutil.AddAliasToColumnName("created_at", alias), f.CreatedAtLTE)
 	}
 
+	if !isZero(f.UpdatedAtGT) {
+		q = q.Where(gopgutil.BuildConditionGT("?"), gopgutil.AddAliasToColumnName("updated_at", alias), f.UpdatedAtGT)
+	}
+
 	return q, nil
 }
 
 func (f *QuestionFilter) Where(q *orm.Query) (*orm.Query, error) {
 	return f.WhereWithAlias(q, "question")
 }
+
+// GeneratedTest is a test along with the seed it has been generated with.
+type GeneratedTest struct {
+	Seed           int                           `json:"seed" xml:"seed" gqlgen:"seed"`
+	Questions      []*Question                   `json:"questions" xml:"questions" gqlgen:"questions"`
+	Qualifications []*QualificationQuestionCount `json:"qualifications" xml:"qualifications" gqlgen:"qualifications"`
+}
+
+func NewGeneratedTest(seed int, questions []*Question) *GeneratedTest {
+	t := &GeneratedTest{
+		Seed:      seed,
+		Questions: questions,
+	}
+	countByQualificationID := make(map[int]*QualificationQuestionCount)
+	for _, q := range questions {
+		count, ok := countByQualificationID[q.QualificationID]
+		if !ok {
+			count = &QualificationQuestionCount{
+				QualificationID: q.QualificationID,
+			}
+			countByQualificationID[q.QualificationID] = count
+			t.Qualifications = append(t.Qualifications, count)
+		}
+		count.Count++
+	}
+	return t
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// QuestionTombstone records that a question has been deleted or moved to another qualification,
+// so that the clients syncing the qualification know they have to remove it.
+// The rows are inserted by a trigger on the questions table.
+type QuestionTombstone struct {
+	tableName struct{} `pg:"alias:question_tombstone"`
+
+	ID              int       `json:"id" xml:"id" gqlgen:"id"`
+	QuestionID      int       `pg:",notnull" json:"questionID" xml:"questionID" gqlgen:"questionID"`
+	QualificationID int       `json:"qualificationID" xml:"qualificationID" gqlgen:"qualificationID"`
+	DeletedAt       time.Time `pg:"default:now(),notnull" json:"deletedAt" xml:"deletedAt" gqlgen:"deletedAt"`
+	ChangeTxID      int64     `pg:"change_txid,default:txid_current(),notnull" json:"-" xml:"-" gqlgen:"-"`
+}
+
+// QuestionChange is a single entry of the change feed, either the last update of a question or its tombstone.
+type QuestionChange struct {
+	QuestionID int `json:"questionID" xml:"questionID" gqlgen:"questionID"`
+	// TxID is the ID of the transaction that made the change (txid_current()), the feed is ordered by it.
+	TxID      int64     `pg:"change_txid" json:"txID" xml:"txID" gqlgen:"txID"`
+	ChangedAt time.Time `json:"changedAt" xml:"changedAt" gqlgen:"changedAt"`
+	Deleted   bool      `json:"deleted" xml:"deleted" gqlgen:"deleted"`
+}
+
+type QuestionChanges struct {
+	Updated    []*Question `json:"updated" xml:"updated" gqlgen:"updated"`
+	DeletedIDs []int       `json:"deletedIDs" xml:"deletedIDs" gqlgen:"deletedIDs"`
+	// Cursor points to the last returned change, the next page (or the next sync) starts right after it.
+	Cursor  string `json:"cursor" xml:"cursor" gqlgen:"cursor"`
+	HasMore bool   `json:"hasMore" xml:"hasMore" gqlgen:"hasMore"`
+}
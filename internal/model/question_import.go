@@ -0,0 +1,121 @@
+package model
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type QuestionImportFormat string
+
+const (
+	QuestionImportFormatCSV  QuestionImportFormat = "csv"
+	QuestionImportFormatJSON QuestionImportFormat = "json"
+)
+
+func (format QuestionImportFormat) IsValid() bool {
+	switch format {
+	case QuestionImportFormatCSV,
+		QuestionImportFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (format QuestionImportFormat) String() string {
+	return string(format)
+}
+
+func (format *QuestionImportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("enums must be strings")
+	}
+
+	*format = QuestionImportFormat(strings.ToLower(str))
+	if !format.IsValid() {
+		return errors.Errorf("%s is not a valid QuestionImportFormat", str)
+	}
+	return nil
+}
+
+func (format QuestionImportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(format.String()))
+}
+
+type QuestionImportStatus string
+
+const (
+	QuestionImportStatusCreated   QuestionImportStatus = "created"
+	QuestionImportStatusDuplicate QuestionImportStatus = "duplicate"
+	QuestionImportStatusError     QuestionImportStatus = "error"
+)
+
+func (status QuestionImportStatus) IsValid() bool {
+	switch status {
+	case QuestionImportStatusCreated,
+		QuestionImportStatusDuplicate,
+		QuestionImportStatusError:
+		return true
+	}
+	return false
+}
+
+func (status QuestionImportStatus) String() string {
+	return string(status)
+}
+
+func (status *QuestionImportStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("enums must be strings")
+	}
+
+	*status = QuestionImportStatus(strings.ToLower(str))
+	if !status.IsValid() {
+		return errors.Errorf("%s is not a valid QuestionImportStatus", str)
+	}
+	return nil
+}
+
+func (status QuestionImportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(status.String()))
+}
+
+// QuestionImportRow is the outcome of importing a single row, rows are numbered from 1 (the CSV header isn't counted).
+type QuestionImportRow struct {
+	Row        int                  `json:"row" xml:"row" gqlgen:"row"`
+	Status     QuestionImportStatus `json:"status" xml:"status" gqlgen:"status"`
+	QuestionID *int                 `json:"questionID" xml:"questionID" gqlgen:"questionID"`
+	Error      string               `json:"error,omitempty" xml:"error" gqlgen:"error"`
+}
+
+type QuestionImportResult struct {
+	DryRun     bool                 `json:"dryRun" xml:"dryRun" gqlgen:"dryRun"`
+	Total      int                  `json:"total" xml:"total" gqlgen:"total"`
+	Created    int                  `json:"created" xml:"created" gqlgen:"created"`
+	Duplicates int                  `json:"duplicates" xml:"duplicates" gqlgen:"duplicates"`
+	Failed     int                  `json:"failed" xml:"failed" gqlgen:"failed"`
+	Rows       []*QuestionImportRow `json:"rows" xml:"rows" gqlgen:"rows"`
+}
+
+func NewQuestionImportResult(dryRun bool, rows []*QuestionImportRow) *QuestionImportResult {
+	result := &QuestionImportResult{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   rows,
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case QuestionImportStatusCreated:
+			result.Created++
+		case QuestionImportStatusDuplicate:
+			result.Duplicates++
+		default:
+			result.Failed++
+		}
+	}
+	return result
+}
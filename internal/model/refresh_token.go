@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+var _ pg.BeforeInsertHook = (*RefreshToken)(nil)
+
+type RefreshToken struct {
+	tableName struct{} `pg:"alias:refresh_token"`
+
+	ID        int        `json:"id" xml:"id" gqlgen:"id"`
+	SessionID string     `json:"sessionID" pg:",type:uuid,notnull,on_delete:CASCADE" xml:"sessionID" gqlgen:"sessionID"`
+	Session   *Session   `json:"session" pg:"rel:has-one" xml:"session" gqlgen:"session"`
+	TokenHash string     `json:"-" pg:",unique,notnull" xml:"-" gqlgen:"-"`
+	CreatedAt time.Time  `json:"createdAt" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
+	ExpiresAt time.Time  `json:"expiresAt" pg:",notnull" xml:"expiresAt" gqlgen:"expiresAt"`
+	UsedAt    *time.Time `json:"usedAt" xml:"usedAt" gqlgen:"usedAt"`
+}
+
+func (t *RefreshToken) BeforeInsert(ctx context.Context) (context.Context, error) {
+	t.CreatedAt = time.Now()
+
+	return ctx, nil
+}
+
+func (t *RefreshToken) IsExpired() bool {
+	return !t.ExpiresAt.After(time.Now())
+}
+
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+type RefreshTokenInput struct {
+	SessionID string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func (input *RefreshTokenInput) ToRefreshToken() *RefreshToken {
+	return &RefreshToken{
+		SessionID: input.SessionID,
+		TokenHash: input.TokenHash,
+		ExpiresAt: input.ExpiresAt,
+	}
+}
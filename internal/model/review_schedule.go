@@ -0,0 +1,106 @@
+package model
+
+import (
+	"github.com/Kichiyaki/gopgutil/v10"
+	"math"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+const (
+	reviewScheduleDefaultEaseFactor = 2.5
+	reviewScheduleMinEaseFactor     = 1.3
+	reviewQualityCorrect            = 4
+	reviewQualityIncorrect          = 1
+	reviewQualityPassing            = 3
+)
+
+// ReviewSchedule decides when the user should see the question again (SM-2 algorithm).
+type ReviewSchedule struct {
+	tableName struct{} `pg:"alias:review_schedule"`
+
+	UserID         int       `json:"userID" pg:",pk,on_delete:CASCADE" xml:"userID" gqlgen:"userID"`
+	QuestionID     int       `json:"questionID" pg:",pk,on_delete:CASCADE" xml:"questionID" gqlgen:"questionID"`
+	Question       *Question `json:"question" pg:"rel:has-one" xml:"question" gqlgen:"question"`
+	Repetitions    int       `json:"repetitions" pg:",use_zero,notnull" xml:"repetitions" gqlgen:"repetitions"`
+	Lapses         int       `json:"lapses" pg:",use_zero,notnull" xml:"lapses" gqlgen:"lapses"`
+	IntervalDays   int       `json:"intervalDays" pg:",use_zero,notnull" xml:"intervalDays" gqlgen:"intervalDays"`
+	EaseFactor     float64   `json:"easeFactor" pg:",use_zero,notnull" xml:"easeFactor" gqlgen:"easeFactor"`
+	DueAt          time.Time `json:"dueAt" pg:",notnull" xml:"dueAt" gqlgen:"dueAt"`
+	LastReviewedAt time.Time `json:"lastReviewedAt" pg:",notnull" xml:"lastReviewedAt" gqlgen:"lastReviewedAt"`
+}
+
+func NewReviewSchedule(userID, questionID int) *ReviewSchedule {
+	return &ReviewSchedule{
+		UserID:     userID,
+		QuestionID: questionID,
+		EaseFactor: reviewScheduleDefaultEaseFactor,
+	}
+}
+
+// Review reschedules the question based on the given answer.
+// A question answered incorrectly is due immediately.
+func (s *ReviewSchedule) Review(correct bool, reviewedAt time.Time) {
+	quality := reviewQualityIncorrect
+	if correct {
+		quality = reviewQualityCorrect
+	}
+
+	if quality >= reviewQualityPassing {
+		switch s.Repetitions {
+		case 0:
+			s.IntervalDays = 1
+		case 1:
+			s.IntervalDays = 6
+		default:
+			s.IntervalDays = int(math.Round(float64(s.IntervalDays) * s.EaseFactor))
+		}
+		s.Repetitions++
+	} else {
+		s.Repetitions = 0
+		s.IntervalDays = 0
+		s.Lapses++
+	}
+
+	diff := float64(5 - quality)
+	s.EaseFactor += 0.1 - diff*(0.08+diff*0.02)
+	if s.EaseFactor < reviewScheduleMinEaseFactor {
+		s.EaseFactor = reviewScheduleMinEaseFactor
+	}
+
+	s.LastReviewedAt = reviewedAt
+	s.DueAt = reviewedAt.AddDate(0, 0, s.IntervalDays)
+}
+
+type ReviewScheduleFilter struct {
+	UserID     []int `json:"userID" xml:"userID" gqlgen:"userID"`
+	QuestionID []int `json:"questionID" xml:"questionID" gqlgen:"questionID"`
+
+	DueAtLTE time.Time `json:"dueAtLTE" xml:"dueAtLTE" gqlgen:"dueAtLTE"`
+}
+
+func (f *ReviewScheduleFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.UserID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("user_id", alias), pg.Array(f.UserID))
+	}
+
+	if !isZero(f.QuestionID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("question_id", alias), pg.Array(f.QuestionID))
+	}
+
+	if !isZero(f.DueAtLTE) {
+		q = q.Where(gopgutil.BuildConditionLTE("?"), gopgutil.AddAliasToColumnName("due_at", alias), f.DueAtLTE)
+	}
+
+	return q, nil
+}
+
+func (f *ReviewScheduleFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "review_schedule")
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/google/uuid"
+)
+
+var _ pg.BeforeInsertHook = (*Session)(nil)
+
+type Session struct {
+	tableName struct{} `pg:"alias:session"`
+
+	ID           string    `json:"id" pg:",pk,type:uuid" xml:"id" gqlgen:"id"`
+	UserID       int       `json:"userID" pg:",notnull,on_delete:CASCADE" xml:"userID" gqlgen:"userID"`
+	User         *User     `json:"user" pg:"rel:has-one" xml:"user" gqlgen:"user"`
+	UserAgent    string    `json:"userAgent" xml:"userAgent" gqlgen:"userAgent"`
+	IP           string    `json:"ip" xml:"ip" gqlgen:"ip"`
+	StaySignedIn bool      `json:"staySignedIn" pg:",use_zero" xml:"staySignedIn" gqlgen:"staySignedIn"`
+	CreatedAt    time.Time `json:"createdAt" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
+	LastSeenAt   time.Time `json:"lastSeenAt" pg:"default:now()" xml:"lastSeenAt" gqlgen:"lastSeenAt"`
+	ExpiresAt    time.Time `json:"expiresAt" pg:",notnull" xml:"expiresAt" gqlgen:"expiresAt"`
+}
+
+func (s *Session) BeforeInsert(ctx context.Context) (context.Context, error) {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	s.CreatedAt = time.Now()
+	s.LastSeenAt = s.CreatedAt
+
+	return ctx, nil
+}
+
+func (s *Session) IsExpired() bool {
+	return !s.ExpiresAt.After(time.Now())
+}
+
+type SessionInput struct {
+	UserID       int
+	UserAgent    string
+	IP           string
+	StaySignedIn bool
+	ExpiresAt    time.Time
+}
+
+func (input *SessionInput) ToSession() *Session {
+	return &Session{
+		UserID:       input.UserID,
+		UserAgent:    input.UserAgent,
+		IP:           input.IP,
+		StaySignedIn: input.StaySignedIn,
+		ExpiresAt:    input.ExpiresAt,
+	}
+}
+
+type SessionFilter struct {
+	ID    []string `json:"id" xml:"id" gqlgen:"id"`
+	IDNEQ []string `json:"idNEQ" xml:"idNEQ" gqlgen:"idNEQ"`
+
+	UserID []int `json:"userID" xml:"userID" gqlgen:"userID"`
+
+	ExpiresAtGT  time.Time `json:"expiresAtGT" xml:"expiresAtGT" gqlgen:"expiresAtGT"`
+	ExpiresAtLTE time.Time `json:"expiresAtLTE" xml:"expiresAtLTE" gqlgen:"expiresAtLTE"`
+}
+
+func (f *SessionFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.ID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("id", alias), pg.Array(f.ID))
+	}
+	if !isZero(f.IDNEQ) {
+		q = q.Where(gopgutil.BuildConditionNotInArray("?"), gopgutil.AddAliasToColumnName("id", alias), pg.Array(f.IDNEQ))
+	}
+
+	if !isZero(f.UserID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("user_id", alias), pg.Array(f.UserID))
+	}
+
+	if !isZero(f.ExpiresAtGT) {
+		q = q.Where(gopgutil.BuildConditionGT("?"), gopgutil.AddAliasToColumnName("expires_at", alias), f.ExpiresAtGT)
+	}
+	if !isZero(f.ExpiresAtLTE) {
+		q = q.Where(gopgutil.BuildConditionLTE("?"), gopgutil.AddAliasToColumnName("expires_at", alias), f.ExpiresAtLTE)
+	}
+
+	return q, nil
+}
+
+func (f *SessionFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "session")
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+var _ pg.BeforeInsertHook = (*SharedTest)(nil)
+
+// SharedTest stores the configuration of a test under a short code,
+// so that everyone who enters the code gets exactly the same questions.
+type SharedTest struct {
+	tableName struct{} `pg:"alias:shared_test"`
+
+	Code             string    `json:"code" pg:",pk" xml:"code" gqlgen:"code"`
+	CreatedByID      int       `json:"createdByID" pg:",notnull,on_delete:CASCADE" xml:"createdByID" gqlgen:"createdByID"`
+	CreatedBy        *User     `json:"createdBy" pg:"rel:has-one" xml:"createdBy" gqlgen:"createdBy"`
+	QualificationIDs []int     `json:"qualificationIDs" pg:",array,notnull" xml:"qualificationIDs" gqlgen:"qualificationIDs"`
+	Limit            int       `json:"limit" pg:",use_zero,notnull" xml:"limit" gqlgen:"limit"`
+	Seed             int       `json:"seed" pg:",use_zero,notnull" xml:"seed" gqlgen:"seed"`
+	Exam             bool      `json:"exam" pg:",use_zero,notnull" xml:"exam" gqlgen:"exam"`
+	CreatedAt        time.Time `json:"createdAt" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
+}
+
+func (t *SharedTest) BeforeInsert(ctx context.Context) (context.Context, error) {
+	t.CreatedAt = time.Now()
+
+	return ctx, nil
+}
+
+type SharedTestInput struct {
+	CreatedByID      int   `json:"-" xml:"-" gqlgen:"-"`
+	QualificationIDs []int `json:"qualificationIDs" xml:"qualificationIDs" gqlgen:"qualificationIDs"`
+	Limit            *int  `json:"limit" xml:"limit" gqlgen:"limit"`
+	Seed             *int  `json:"seed" xml:"seed" gqlgen:"seed"`
+	Exam             *bool `json:"exam" xml:"exam" gqlgen:"exam"`
+}
+
+func (input *SharedTestInput) ToSharedTest() *SharedTest {
+	t := &SharedTest{
+		CreatedByID:      input.CreatedByID,
+		QualificationIDs: input.QualificationIDs,
+	}
+	if input.Limit != nil {
+		t.Limit = *input.Limit
+	}
+	if input.Seed != nil {
+		t.Seed = *input.Seed
+	}
+	if input.Exam != nil {
+		t.Exam = *input.Exam
+	}
+	return t
+}
+
+type SharedTestFilter struct {
+	Code []string `json:"code" xml:"code" gqlgen:"code"`
+
+	CreatedByID []int `json:"createdByID" xml:"createdByID" gqlgen:"createdByID"`
+}
+
+func (f *SharedTestFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.Code) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("code", alias), pg.Array(f.Code))
+	}
+
+	if !isZero(f.CreatedByID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("created_by_id", alias), pg.Array(f.CreatedByID))
+	}
+
+	return q, nil
+}
+
+func (f *SharedTestFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "shared_test")
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// AnswerStatistics is a summary of answers given to the questions of submitted test attempts.
+// Questions left without an answer count as answered incorrectly.
+type AnswerStatistics struct {
+	Questions int     `json:"questions" pg:",use_zero" xml:"questions" gqlgen:"questions"`
+	Answered  int     `json:"answered" pg:",use_zero" xml:"answered" gqlgen:"answered"`
+	Correct   int     `json:"correct" pg:",use_zero" xml:"correct" gqlgen:"correct"`
+	Accuracy  float64 `json:"accuracy" pg:",use_zero" xml:"accuracy" gqlgen:"accuracy"`
+}
+
+type QualificationStatistics struct {
+	AnswerStatistics
+	QualificationID int            `json:"qualificationID" xml:"qualificationID" gqlgen:"qualificationID"`
+	Qualification   *Qualification `json:"qualification" pg:"-" xml:"qualification" gqlgen:"qualification"`
+}
+
+type SourceStatistics struct {
+	AnswerStatistics
+	From string `json:"from" xml:"from" gqlgen:"from"`
+}
+
+type DailyStatistics struct {
+	AnswerStatistics
+	Date time.Time `json:"date" xml:"date" gqlgen:"date"`
+}
+
+type StreakStatistics struct {
+	Current int `json:"current" pg:",use_zero" xml:"current" gqlgen:"current"`
+	Longest int `json:"longest" pg:",use_zero" xml:"longest" gqlgen:"longest"`
+}
+
+type UserStatistics struct {
+	AnswerStatistics
+	UserID                int                        `json:"userID" xml:"userID" gqlgen:"userID"`
+	Attempts              int                        `json:"attempts" xml:"attempts" gqlgen:"attempts"`
+	Streak                *StreakStatistics          `json:"streak" pg:"-" xml:"streak" gqlgen:"streak"`
+	ByQualification       []*QualificationStatistics `json:"byQualification" pg:"-" xml:"byQualification" gqlgen:"byQualification"`
+	BySource              []*SourceStatistics        `json:"bySource" pg:"-" xml:"bySource" gqlgen:"bySource"`
+	OverTime              []*DailyStatistics         `json:"overTime" pg:"-" xml:"overTime" gqlgen:"overTime"`
+	WeakestQualifications []*QualificationStatistics `json:"weakestQualifications" pg:"-" xml:"weakestQualifications" gqlgen:"weakestQualifications"`
+}
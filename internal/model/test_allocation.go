@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TestAllocation decides how the questions of a test are split between the selected qualifications.
+type TestAllocation string
+
+const (
+	// TestAllocationProportional draws the questions from all qualifications at once,
+	// so bigger qualifications get more questions.
+	TestAllocationProportional TestAllocation = "proportional"
+	// TestAllocationEqual gives every qualification the same number of questions,
+	// the ones with too few questions are made up for by the others.
+	TestAllocationEqual TestAllocation = "equal"
+	// TestAllocationExplicit takes the number of questions for every qualification from the caller.
+	TestAllocationExplicit TestAllocation = "explicit"
+)
+
+func (allocation TestAllocation) IsValid() bool {
+	switch allocation {
+	case TestAllocationProportional,
+		TestAllocationEqual,
+		TestAllocationExplicit:
+		return true
+	}
+	return false
+}
+
+func (allocation TestAllocation) String() string {
+	return string(allocation)
+}
+
+func (allocation *TestAllocation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("enums must be strings")
+	}
+
+	*allocation = TestAllocation(strings.ToLower(str))
+	if !allocation.IsValid() {
+		return errors.Errorf("%s is not a valid TestAllocation", str)
+	}
+	return nil
+}
+
+func (allocation TestAllocation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(allocation.String()))
+}
+
+// QualificationQuestionCount is the number of questions of a test that belong to the qualification.
+type QualificationQuestionCount struct {
+	QualificationID int            `json:"qualificationID" xml:"qualificationID" gqlgen:"qualificationID"`
+	Qualification   *Qualification `json:"qualification" xml:"qualification" gqlgen:"qualification"`
+	Count           int            `json:"count" xml:"count" gqlgen:"count"`
+}
+
+type QualificationQuestionCountInput struct {
+	QualificationID int `json:"qualificationID" xml:"qualificationID" gqlgen:"qualificationID"`
+	Count           int `json:"count" xml:"count" gqlgen:"count"`
+}
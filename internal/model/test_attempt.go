@@ -0,0 +1,194 @@
+package model
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+var _ pg.BeforeInsertHook = (*TestAttempt)(nil)
+
+// TestAttempt is a test generated for a user along with the answers they submitted.
+type TestAttempt struct {
+	tableName struct{} `pg:"alias:test_attempt"`
+
+	ID               int                  `json:"id" xml:"id" gqlgen:"id"`
+	UserID           int                  `json:"userID" pg:",notnull,on_delete:CASCADE" xml:"userID" gqlgen:"userID"`
+	User             *User                `json:"user" pg:"rel:has-one" xml:"user" gqlgen:"user"`
+	QualificationIDs []int                `json:"qualificationIDs" pg:",array" xml:"qualificationIDs" gqlgen:"qualificationIDs"`
+	QuestionIDs      []int                `json:"questionIDs" pg:",array,notnull" xml:"questionIDs" gqlgen:"questionIDs"`
+	Score            *int                 `json:"score" xml:"score" gqlgen:"score"`
+	Seed             int                  `json:"seed" pg:",use_zero,notnull,default:0" xml:"seed" gqlgen:"seed"`
+	Exam             bool                 `json:"exam" pg:",use_zero,notnull,default:false" xml:"exam" gqlgen:"exam"`
+	ShuffleAnswers   bool                 `json:"shuffleAnswers" pg:",use_zero,notnull,default:false" xml:"shuffleAnswers" gqlgen:"shuffleAnswers"`
+	Deadline         *time.Time           `json:"deadline" xml:"deadline" gqlgen:"deadline"`
+	StartedAt        time.Time            `json:"startedAt" pg:"default:now()" xml:"startedAt" gqlgen:"startedAt"`
+	SubmittedAt      *time.Time           `json:"submittedAt" xml:"submittedAt" gqlgen:"submittedAt"`
+	Answers          []*TestAttemptAnswer `json:"answers" pg:"rel:has-many" xml:"answers" gqlgen:"answers"`
+
+	// Questions are the served questions, in the order they have been served in.
+	// They're populated only when the attempt is started.
+	Questions []*Question `json:"questions" pg:"-" xml:"questions" gqlgen:"questions"`
+}
+
+func (a *TestAttempt) BeforeInsert(ctx context.Context) (context.Context, error) {
+	a.StartedAt = time.Now()
+
+	return ctx, nil
+}
+
+func (a *TestAttempt) IsSubmitted() bool {
+	return a.SubmittedAt != nil
+}
+
+// IsOverdue reports whether the attempt has a deadline that had passed before the given time.
+func (a *TestAttempt) IsOverdue(t time.Time) bool {
+	return a.Deadline != nil && t.After(*a.Deadline)
+}
+
+// AnswerPermutation returns the order in which the answers of the given question are displayed in this attempt.
+func (a *TestAttempt) AnswerPermutation(questionID int) AnswerPermutation {
+	if !a.ShuffleAnswers {
+		return NewIdentityAnswerPermutation()
+	}
+	return NewAnswerPermutation(mix64(uint64(a.ID)<<32|uint64(uint32(a.Seed))) ^ uint64(questionID))
+}
+
+// PermuteQuestions returns the questions with the answers displayed in the order of this attempt.
+// The given questions are left untouched.
+func (a *TestAttempt) PermuteQuestions(questions []*Question) []*Question {
+	if !a.ShuffleAnswers {
+		return questions
+	}
+	permuted := make([]*Question, len(questions))
+	for i, q := range questions {
+		permuted[i] = q.WithPermutedAnswers(a.AnswerPermutation(q.ID))
+	}
+	return permuted
+}
+
+// DisplayedAnswers returns the submitted answers translated to the options the user has seen.
+func (a *TestAttempt) DisplayedAnswers() []*TestAttemptAnswer {
+	if !a.ShuffleAnswers {
+		return a.Answers
+	}
+	displayed := make([]*TestAttemptAnswer, len(a.Answers))
+	for i, answer := range a.Answers {
+		copied := *answer
+		if answer.Answer != nil {
+			displayedAnswer := a.AnswerPermutation(answer.QuestionID).ToDisplayed(*answer.Answer)
+			copied.Answer = &displayedAnswer
+		}
+		displayed[i] = &copied
+	}
+	return displayed
+}
+
+func (a *TestAttempt) MaxScore() int {
+	return len(a.QuestionIDs)
+}
+
+func (a *TestAttempt) HasQuestion(id int) bool {
+	for _, questionID := range a.QuestionIDs {
+		if questionID == id {
+			return true
+		}
+	}
+	return false
+}
+
+type TestAttemptAnswer struct {
+	tableName struct{} `pg:"alias:test_attempt_answer"`
+
+	ID            int       `json:"id" xml:"id" gqlgen:"id"`
+	TestAttemptID int       `json:"testAttemptID" pg:",notnull,unique:test_attempt_question,on_delete:CASCADE" xml:"testAttemptID" gqlgen:"testAttemptID"`
+	QuestionID    int       `json:"questionID" pg:",notnull,unique:test_attempt_question,on_delete:CASCADE" xml:"questionID" gqlgen:"questionID"`
+	Question      *Question `json:"question" pg:"rel:has-one" xml:"question" gqlgen:"question"`
+	Answer        *Answer   `json:"answer" xml:"answer" gqlgen:"answer"`
+	Correct       bool      `json:"correct" pg:",use_zero,notnull" xml:"correct" gqlgen:"correct"`
+}
+
+type TestAttemptInput struct {
+	UserID           int
+	QualificationIDs []int
+	QuestionIDs      []int
+	Seed             int
+	Exam             bool
+	ShuffleAnswers   bool
+	Deadline         *time.Time
+}
+
+func (input *TestAttemptInput) ToTestAttempt() *TestAttempt {
+	return &TestAttempt{
+		UserID:           input.UserID,
+		QualificationIDs: input.QualificationIDs,
+		QuestionIDs:      input.QuestionIDs,
+		Seed:             input.Seed,
+		Exam:             input.Exam,
+		ShuffleAnswers:   input.ShuffleAnswers,
+		Deadline:         input.Deadline,
+	}
+}
+
+type TestAttemptAnswerInput struct {
+	QuestionID int     `json:"questionID" xml:"questionID" gqlgen:"questionID"`
+	Answer     *Answer `json:"answer" xml:"answer" gqlgen:"answer"`
+}
+
+type TestAttemptFilter struct {
+	ID    []int `json:"id" xml:"id" gqlgen:"id"`
+	IDNEQ []int `json:"idNEQ" xml:"idNEQ" gqlgen:"idNEQ"`
+
+	UserID []int `json:"userID" xml:"userID" gqlgen:"userID"`
+
+	Submitted *bool `json:"submitted" xml:"submitted" gqlgen:"submitted"`
+	Exam      *bool `json:"exam" xml:"exam" gqlgen:"exam"`
+
+	StartedAtGTE time.Time `json:"startedAtGTE" xml:"startedAtGTE" gqlgen:"startedAtGTE"`
+	StartedAtLTE time.Time `json:"startedAtLTE" xml:"startedAtLTE" gqlgen:"startedAtLTE"`
+}
+
+func (f *TestAttemptFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.ID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("id", alias), pg.Array(f.ID))
+	}
+	if !isZero(f.IDNEQ) {
+		q = q.Where(gopgutil.BuildConditionNotInArray("?"), gopgutil.AddAliasToColumnName("id", alias), pg.Array(f.IDNEQ))
+	}
+
+	if !isZero(f.UserID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("user_id", alias), pg.Array(f.UserID))
+	}
+
+	if f.Submitted != nil {
+		if *f.Submitted {
+			q = q.Where("? IS NOT NULL", gopgutil.AddAliasToColumnName("submitted_at", alias))
+		} else {
+			q = q.Where("? IS NULL", gopgutil.AddAliasToColumnName("submitted_at", alias))
+		}
+	}
+
+	if f.Exam != nil {
+		q = q.Where(gopgutil.BuildConditionEquals("?"), gopgutil.AddAliasToColumnName("exam", alias), *f.Exam)
+	}
+
+	if !isZero(f.StartedAtGTE) {
+		q = q.Where(gopgutil.BuildConditionGTE("?"), gopgutil.AddAliasToColumnName("started_at", alias), f.StartedAtGTE)
+	}
+	if !isZero(f.StartedAtLTE) {
+		q = q.Where(gopgutil.BuildConditionLTE("?"), gopgutil.AddAliasToColumnName("started_at", alias), f.StartedAtLTE)
+	}
+
+	return q, nil
+}
+
+func (f *TestAttemptFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "test_attempt")
+}
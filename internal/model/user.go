@@ -38,10 +38,11 @@ func (u *User) BeforeInsert(ctx context.Context) (context.Context, error) {
 	return ctx, nil
 }
 
+func (u *User) IsActivated() bool {
+	return u.Activated != nil && *u.Activated
+}
+
 func (u *User) CompareHashAndPassword(password string) error {
-	if password == u.Password {
-		return nil
-	}
 	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
 		return errors.Wrap(err, "CompareHashAndPassword")
 	}
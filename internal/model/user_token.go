@@ -0,0 +1,102 @@
+package model
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+type UserTokenType string
+
+const (
+	UserTokenTypeActivation    UserTokenType = "activation"
+	UserTokenTypePasswordReset UserTokenType = "password_reset"
+)
+
+var _ pg.BeforeInsertHook = (*UserToken)(nil)
+
+// UserToken is a one-time token sent to the user by e-mail. Only its hash is stored in the database.
+type UserToken struct {
+	tableName struct{} `pg:"alias:user_token"`
+
+	ID        int           `json:"id" xml:"id" gqlgen:"id"`
+	UserID    int           `json:"userID" pg:",notnull,on_delete:CASCADE" xml:"userID" gqlgen:"userID"`
+	User      *User         `json:"user" pg:"rel:has-one" xml:"user" gqlgen:"user"`
+	Type      UserTokenType `json:"type" pg:",notnull" xml:"type" gqlgen:"type"`
+	TokenHash string        `json:"-" pg:",unique,notnull" xml:"-" gqlgen:"-"`
+	CreatedAt time.Time     `json:"createdAt" pg:"default:now()" xml:"createdAt" gqlgen:"createdAt"`
+	ExpiresAt time.Time     `json:"expiresAt" pg:",notnull" xml:"expiresAt" gqlgen:"expiresAt"`
+	UsedAt    *time.Time    `json:"usedAt" xml:"usedAt" gqlgen:"usedAt"`
+}
+
+func (t *UserToken) BeforeInsert(ctx context.Context) (context.Context, error) {
+	t.CreatedAt = time.Now()
+
+	return ctx, nil
+}
+
+func (t *UserToken) IsExpired() bool {
+	return !t.ExpiresAt.After(time.Now())
+}
+
+func (t *UserToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+type UserTokenInput struct {
+	UserID    int
+	Type      UserTokenType
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func (input *UserTokenInput) ToUserToken() *UserToken {
+	return &UserToken{
+		UserID:    input.UserID,
+		Type:      input.Type,
+		TokenHash: input.TokenHash,
+		ExpiresAt: input.ExpiresAt,
+	}
+}
+
+type UserTokenFilter struct {
+	ID     []int           `json:"id" xml:"id" gqlgen:"id"`
+	UserID []int           `json:"userID" xml:"userID" gqlgen:"userID"`
+	Type   []UserTokenType `json:"type" xml:"type" gqlgen:"type"`
+	Used   *bool           `json:"used" xml:"used" gqlgen:"used"`
+}
+
+func (f *UserTokenFilter) WhereWithAlias(q *orm.Query, alias string) (*orm.Query, error) {
+	if f == nil {
+		return q, nil
+	}
+
+	if !isZero(f.ID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("id", alias), pg.Array(f.ID))
+	}
+
+	if !isZero(f.UserID) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("user_id", alias), pg.Array(f.UserID))
+	}
+
+	if !isZero(f.Type) {
+		q = q.Where(gopgutil.BuildConditionArray("?"), gopgutil.AddAliasToColumnName("type", alias), pg.Array(f.Type))
+	}
+
+	if f.Used != nil {
+		if *f.Used {
+			q = q.Where("? IS NOT NULL", gopgutil.AddAliasToColumnName("used_at", alias))
+		} else {
+			q = q.Where("? IS NULL", gopgutil.AddAliasToColumnName("used_at", alias))
+		}
+	}
+
+	return q, nil
+}
+
+func (f *UserTokenFilter) Where(q *orm.Query) (*orm.Query, error) {
+	return f.WhereWithAlias(q, "user_token")
+}
@@ -61,6 +61,16 @@ func createSchema(db *pg.DB) error {
 			(*model.Qualification)(nil),
 			(*model.QualificationToProfession)(nil),
 			(*model.Question)(nil),
+			(*model.Session)(nil),
+			(*model.RefreshToken)(nil),
+			(*model.UserToken)(nil),
+			(*model.LoginLockout)(nil),
+			(*model.TestAttempt)(nil),
+			(*model.TestAttemptAnswer)(nil),
+			(*model.ReviewSchedule)(nil),
+			(*model.SharedTest)(nil),
+			(*model.QuestionTombstone)(nil),
+			(*model.FileDeletion)(nil),
 		}
 
 		for _, model := range modelsToCreate {
@@ -73,6 +83,82 @@ func createSchema(db *pg.DB) error {
 			}
 		}
 
+		// CreateTable doesn't modify the existing tables nor creates indexes, they have to be added manually
+		alterations := []string{
+			"ALTER TABLE qualifications ADD COLUMN IF NOT EXISTS exam_question_count bigint NOT NULL DEFAULT 40",
+			"ALTER TABLE qualifications ADD COLUMN IF NOT EXISTS exam_duration_minutes bigint NOT NULL DEFAULT 60",
+			"ALTER TABLE test_attempts ADD COLUMN IF NOT EXISTS exam boolean NOT NULL DEFAULT false",
+			"ALTER TABLE test_attempts ADD COLUMN IF NOT EXISTS deadline timestamptz",
+			"ALTER TABLE test_attempts ADD COLUMN IF NOT EXISTS seed bigint NOT NULL DEFAULT 0",
+			"ALTER TABLE test_attempts ADD COLUMN IF NOT EXISTS shuffle_answers boolean NOT NULL DEFAULT false",
+			"ALTER TABLE questions ADD COLUMN IF NOT EXISTS random_key double precision NOT NULL DEFAULT random()",
+			"ALTER TABLE questions ADD COLUMN IF NOT EXISTS image_variants jsonb NOT NULL DEFAULT '{}'",
+			"CREATE INDEX IF NOT EXISTS questions_qualification_id_random_key_idx ON questions (qualification_id, random_key)",
+			"CREATE INDEX IF NOT EXISTS questions_updated_at_id_idx ON questions (updated_at, id)",
+			"CREATE INDEX IF NOT EXISTS question_tombstones_deleted_at_question_id_idx ON question_tombstones (deleted_at, question_id)",
+			// the change feed is keyed on the ID of the transaction that made the change instead of the timestamps,
+			// a transaction that commits late still has a lower ID than the transactions that are in progress
+			"ALTER TABLE questions ADD COLUMN IF NOT EXISTS change_txid bigint NOT NULL DEFAULT txid_current()",
+			"ALTER TABLE question_tombstones ADD COLUMN IF NOT EXISTS change_txid bigint NOT NULL DEFAULT txid_current()",
+			"CREATE INDEX IF NOT EXISTS questions_change_txid_id_idx ON questions (change_txid, id)",
+			"CREATE INDEX IF NOT EXISTS question_tombstones_change_txid_question_id_idx ON question_tombstones (change_txid, question_id)",
+			`CREATE OR REPLACE FUNCTION set_question_change_txid() RETURNS trigger AS $$
+			BEGIN
+				NEW.change_txid := txid_current();
+				RETURN NEW;
+			END;
+			$$ LANGUAGE plpgsql`,
+			"DROP TRIGGER IF EXISTS questions_set_change_txid ON questions",
+			"CREATE TRIGGER questions_set_change_txid BEFORE INSERT OR UPDATE ON questions FOR EACH ROW EXECUTE PROCEDURE set_question_change_txid()",
+			// the tombstones are written by a trigger, so that the questions deleted along with their qualification
+			// and the ones moved to another qualification are recorded too
+			`CREATE OR REPLACE FUNCTION record_question_tombstone() RETURNS trigger AS $$
+			BEGIN
+				IF TG_OP = 'UPDATE' AND NEW.qualification_id IS NOT DISTINCT FROM OLD.qualification_id THEN
+					RETURN NULL;
+				END IF;
+				INSERT INTO question_tombstones (question_id, qualification_id, deleted_at)
+				VALUES (OLD.id, OLD.qualification_id, now());
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql`,
+			"DROP TRIGGER IF EXISTS questions_record_tombstone ON questions",
+			"CREATE TRIGGER questions_record_tombstone AFTER DELETE OR UPDATE OF qualification_id ON questions FOR EACH ROW EXECUTE PROCEDURE record_question_tombstone()",
+			"CREATE INDEX IF NOT EXISTS file_deletions_process_after_id_idx ON file_deletions (process_after, id)",
+			"CREATE INDEX IF NOT EXISTS file_deletions_filename_idx ON file_deletions (filename)",
+			`CREATE OR REPLACE FUNCTION question_files(q questions) RETURNS SETOF text AS $$
+				SELECT image_file.filename
+				FROM unnest(ARRAY[q.image, q.answer_a_image, q.answer_b_image, q.answer_c_image, q.answer_d_image]) AS image_file(filename)
+				WHERE coalesce(image_file.filename, '') <> ''
+				UNION
+				SELECT variant.value->>'filename'
+				FROM jsonb_each(coalesce(q.image_variants, '{}')) AS image(filename, variants),
+					jsonb_array_elements(image.variants) AS variant(value)
+				WHERE coalesce(variant.value->>'filename', '') <> ''
+			$$ LANGUAGE sql STABLE`,
+			// the files are scheduled for deletion by a trigger, so that they're removed only if the change is committed,
+			// the questions deleted along with their qualification are covered too
+			`CREATE OR REPLACE FUNCTION schedule_question_file_deletions() RETURNS trigger AS $$
+			BEGIN
+				IF TG_OP = 'DELETE' THEN
+					INSERT INTO file_deletions (filename) SELECT old_file.filename FROM question_files(OLD) AS old_file(filename);
+				ELSE
+					INSERT INTO file_deletions (filename)
+					SELECT old_file.filename FROM question_files(OLD) AS old_file(filename)
+					WHERE old_file.filename NOT IN (SELECT new_file.filename FROM question_files(NEW) AS new_file(filename));
+				END IF;
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql`,
+			"DROP TRIGGER IF EXISTS questions_schedule_file_deletions ON questions",
+			"CREATE TRIGGER questions_schedule_file_deletions AFTER DELETE OR UPDATE OF image, answer_a_image, answer_b_image, answer_c_image, answer_d_image, image_variants ON questions FOR EACH ROW EXECUTE PROCEDURE schedule_question_file_deletions()",
+		}
+		for _, alteration := range alterations {
+			if _, err := tx.Exec(alteration); err != nil {
+				return errors.Wrap(err, "couldn't alter the table")
+			}
+		}
+
 		total, err := tx.Model(modelsToCreate[0]).Where("role = ?", model.RoleAdmin).Count()
 		if err != nil {
 			return errors.Wrap(err, "couldn't count admins")
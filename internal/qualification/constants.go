@@ -4,4 +4,7 @@ const (
 	FetchDefaultLimit = 100
 	MaxNameLength     = 200
 	MaxOrders         = 3
+	// MaxExamQuestionCount is the same as the max number of questions in a generated test (question.TestMaxLimit).
+	MaxExamQuestionCount   = 40
+	MaxExamDurationMinutes = 240
 )
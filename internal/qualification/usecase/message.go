@@ -8,4 +8,6 @@ const (
 	messageCodeIsRequired            = "Oznaczenie kwalifikacji jest wymagane."
 	messageNameIsTooLong             = "Nazwa kwalifikacji może się składać z maksymalnie %d znaków."
 	messageQualificationIDIsRequired = "ID kwalifikacji jest wymagane."
+	messageInvalidExamQuestionCount  = "Liczba pytań na egzaminie powinna wynosić 1-%d."
+	messageInvalidExamDuration       = "Czas trwania egzaminu powinien wynosić 1-%d min."
 )
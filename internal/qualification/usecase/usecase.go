@@ -140,5 +140,17 @@ func validateInput(input *model.QualificationInput, opts validateOptions) error
 		return errors.New(messageCodeIsRequired)
 	}
 
+	if input.ExamQuestionCount != nil {
+		if *input.ExamQuestionCount <= 0 || *input.ExamQuestionCount > qualification.MaxExamQuestionCount {
+			return errors.Errorf(messageInvalidExamQuestionCount, qualification.MaxExamQuestionCount)
+		}
+	}
+
+	if input.ExamDurationMinutes != nil {
+		if *input.ExamDurationMinutes <= 0 || *input.ExamDurationMinutes > qualification.MaxExamDurationMinutes {
+			return errors.Errorf(messageInvalidExamDuration, qualification.MaxExamDurationMinutes)
+		}
+	}
+
 	return nil
 }
@@ -1,8 +1,16 @@
 package question
 
+import "math"
+
 const (
-	FetchDefaultLimit = 100
-	FetchMaxLimit     = 500
-	TestMaxLimit      = 40
-	MaxOrders         = 3
+	FetchDefaultLimit   = 100
+	FetchMaxLimit       = 500
+	TestMaxLimit        = 40
+	MaxOrders           = 3
+	ImportMaxRows       = 5000
+	IterateBatchSize    = 200
+	ChangesDefaultLimit = 100
+	ChangesMaxLimit     = 500
+	// MaxSeed keeps the seed within the range of the GraphQL Int type.
+	MaxSeed = math.MaxInt32
 )
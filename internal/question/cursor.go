@@ -0,0 +1,53 @@
+package question
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/pkg/errors"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+// ChangeCursor is the position in the change feed, the changes are ordered by (TxID, QuestionID, Deleted).
+type ChangeCursor struct {
+	TxID       int64
+	QuestionID int
+	Deleted    bool
+}
+
+func NewChangeCursor(change *model.QuestionChange) *ChangeCursor {
+	return &ChangeCursor{
+		TxID:       change.TxID,
+		QuestionID: change.QuestionID,
+		Deleted:    change.Deleted,
+	}
+}
+
+// String encodes the cursor, so that it can be passed to the clients.
+func (c *ChangeCursor) String() string {
+	deleted := 0
+	if c.Deleted {
+		deleted = 1
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d.%d.%d", c.TxID, c.QuestionID, deleted)))
+}
+
+func ParseChangeCursor(s string) (*ChangeCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decode the cursor")
+	}
+	var txID int64
+	var questionID, deleted int
+	if _, err := fmt.Sscanf(string(b), "%d.%d.%d", &txID, &questionID, &deleted); err != nil {
+		return nil, errors.Wrap(err, "couldn't parse the cursor")
+	}
+	if txID < 0 || questionID < 0 || (deleted != 0 && deleted != 1) {
+		return nil, errors.New("the cursor is out of range")
+	}
+	return &ChangeCursor{
+		TxID:       txID,
+		QuestionID: questionID,
+		Deleted:    deleted == 1,
+	}, nil
+}
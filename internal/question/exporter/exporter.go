@@ -0,0 +1,219 @@
+// Package exporter writes questions as JSON Lines, CSV or a printable HTML worksheet.
+// The writers don't buffer the questions (except for the answer key of the worksheet),
+// so they can be used together with question.Usecase.Iterate to export banks of any size.
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Format string
+
+const (
+	FormatJSONLines Format = "jsonl"
+	FormatCSV       Format = "csv"
+	FormatHTML      Format = "html"
+)
+
+func (format Format) IsValid() bool {
+	switch format {
+	case FormatJSONLines,
+		FormatCSV,
+		FormatHTML:
+		return true
+	}
+	return false
+}
+
+func (format Format) ContentType() string {
+	switch format {
+	case FormatJSONLines:
+		return "application/x-ndjson; charset=utf-8"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+func (format Format) Extension() string {
+	return string(format)
+}
+
+type Config struct {
+	// Title is displayed at the top of the HTML worksheet.
+	Title string
+	// ImageURL turns the filename of an image into an URL, the filenames are exported as they are if it's nil.
+	ImageURL func(filename string) string
+}
+
+type Writer interface {
+	Write(q *model.Question) error
+	// Close flushes the buffered data, it doesn't close the underlying writer.
+	Close() error
+}
+
+func NewWriter(format Format, w io.Writer, cfg *Config) (Writer, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	switch format {
+	case FormatJSONLines:
+		return newJSONLinesWriter(w, cfg), nil
+	case FormatCSV:
+		return newCSVWriter(w, cfg), nil
+	case FormatHTML:
+		return newHTMLWriter(w, cfg)
+	}
+	return nil, errors.Errorf("unsupported format: %s", format)
+}
+
+// record is a question with the image references resolved.
+type record struct {
+	ID              int          `json:"id"`
+	QualificationID int          `json:"qualificationID"`
+	From            string       `json:"from"`
+	Content         string       `json:"content"`
+	Explanation     string       `json:"explanation"`
+	CorrectAnswer   model.Answer `json:"correctAnswer"`
+	Image           string       `json:"image"`
+	AnswerA         string       `json:"answerA"`
+	AnswerAImage    string       `json:"answerAImage"`
+	AnswerB         string       `json:"answerB"`
+	AnswerBImage    string       `json:"answerBImage"`
+	AnswerC         string       `json:"answerC"`
+	AnswerCImage    string       `json:"answerCImage"`
+	AnswerD         string       `json:"answerD"`
+	AnswerDImage    string       `json:"answerDImage"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+}
+
+func newRecord(q *model.Question, cfg *Config) *record {
+	imageURL := func(filename string) string {
+		if filename == "" || cfg.ImageURL == nil {
+			return filename
+		}
+		return cfg.ImageURL(filename)
+	}
+	return &record{
+		ID:              q.ID,
+		QualificationID: q.QualificationID,
+		From:            q.From,
+		Content:         q.Content,
+		Explanation:     q.Explanation,
+		CorrectAnswer:   q.CorrectAnswer,
+		Image:           imageURL(q.Image),
+		AnswerA:         q.AnswerA,
+		AnswerAImage:    imageURL(q.AnswerAImage),
+		AnswerB:         q.AnswerB,
+		AnswerBImage:    imageURL(q.AnswerBImage),
+		AnswerC:         q.AnswerC,
+		AnswerCImage:    imageURL(q.AnswerCImage),
+		AnswerD:         q.AnswerD,
+		AnswerDImage:    imageURL(q.AnswerDImage),
+		CreatedAt:       q.CreatedAt,
+		UpdatedAt:       q.UpdatedAt,
+	}
+}
+
+type jsonLinesWriter struct {
+	encoder *json.Encoder
+	cfg     *Config
+}
+
+func newJSONLinesWriter(w io.Writer, cfg *Config) *jsonLinesWriter {
+	return &jsonLinesWriter{
+		encoder: json.NewEncoder(w),
+		cfg:     cfg,
+	}
+}
+
+func (w *jsonLinesWriter) Write(q *model.Question) error {
+	return w.encoder.Encode(newRecord(q, w.cfg))
+}
+
+func (w *jsonLinesWriter) Close() error {
+	return nil
+}
+
+var csvHeader = []string{
+	"id",
+	"qualificationID",
+	"from",
+	"content",
+	"explanation",
+	"correctAnswer",
+	"image",
+	"answerA",
+	"answerAImage",
+	"answerB",
+	"answerBImage",
+	"answerC",
+	"answerCImage",
+	"answerD",
+	"answerDImage",
+	"createdAt",
+	"updatedAt",
+}
+
+type csvWriter struct {
+	writer        *csv.Writer
+	cfg           *Config
+	headerWritten bool
+}
+
+func newCSVWriter(w io.Writer, cfg *Config) *csvWriter {
+	return &csvWriter{
+		writer: csv.NewWriter(w),
+		cfg:    cfg,
+	}
+}
+
+func (w *csvWriter) Write(q *model.Question) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	r := newRecord(q, w.cfg)
+	return w.writer.Write([]string{
+		strconv.Itoa(r.ID),
+		strconv.Itoa(r.QualificationID),
+		r.From,
+		r.Content,
+		r.Explanation,
+		strings.ToUpper(r.CorrectAnswer.String()),
+		r.Image,
+		r.AnswerA,
+		r.AnswerAImage,
+		r.AnswerB,
+		r.AnswerBImage,
+		r.AnswerC,
+		r.AnswerCImage,
+		r.AnswerD,
+		r.AnswerDImage,
+		r.CreatedAt.Format(time.RFC3339),
+		r.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+func (w *csvWriter) Close() error {
+	if !w.headerWritten {
+		if err := w.writer.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
@@ -0,0 +1,113 @@
+package exporter
+
+import (
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+var (
+	htmlHeaderTemplate = template.Must(template.New("header").Parse(`<!DOCTYPE html>
+<html lang="pl">
+<head>
+<meta charset="utf-8">
+<title>{{ . }}</title>
+<style>
+body { font-family: sans-serif; max-width: 800px; margin: 0 auto; padding: 16px; }
+.question { break-inside: avoid; page-break-inside: avoid; margin-bottom: 24px; }
+.question img { max-width: 100%; }
+.answers { list-style: none; padding-left: 0; }
+.answers li { margin: 4px 0; }
+.answer-key { break-before: page; page-break-before: always; }
+.answer-key table { border-collapse: collapse; }
+.answer-key td, .answer-key th { border: 1px solid #000; padding: 4px 8px; }
+</style>
+</head>
+<body>
+<h1>{{ . }}</h1>
+`))
+	htmlQuestionTemplate = template.Must(template.New("question").Parse(`<div class="question">
+<p><strong>{{ .Number }}.</strong> {{ .Content }}</p>
+{{- if .Image }}
+<img src="{{ .Image }}" alt="">
+{{- end }}
+<ul class="answers">
+{{- range .Answers }}
+<li><strong>{{ .Letter }}.</strong> {{ .Content }}{{ if .Image }}{{ if .Content }}<br>{{ end }}<img src="{{ .Image }}" alt="">{{ end }}</li>
+{{- end }}
+</ul>
+</div>
+`))
+	htmlFooterTemplate = template.Must(template.New("footer").Funcs(template.FuncMap{
+		"inc": func(i int) int {
+			return i + 1
+		},
+	}).Parse(`<div class="answer-key">
+<h2>Klucz odpowiedzi</h2>
+<table>
+<tr><th>Pytanie</th><th>Odpowiedź</th></tr>
+{{- range $index, $answer := . }}
+<tr><td>{{ inc $index }}</td><td>{{ $answer }}</td></tr>
+{{- end }}
+</table>
+</div>
+</body>
+</html>
+`))
+)
+
+type htmlAnswer struct {
+	Letter  string
+	Content string
+	Image   string
+}
+
+type htmlQuestion struct {
+	Number  int
+	Content string
+	Image   string
+	Answers []htmlAnswer
+}
+
+// htmlWriter writes a worksheet, the answer key is written at the end on a separate page.
+type htmlWriter struct {
+	w         io.Writer
+	cfg       *Config
+	answerKey []string
+}
+
+func newHTMLWriter(w io.Writer, cfg *Config) (*htmlWriter, error) {
+	title := cfg.Title
+	if title == "" {
+		title = "Pytania"
+	}
+	if err := htmlHeaderTemplate.Execute(w, title); err != nil {
+		return nil, err
+	}
+	return &htmlWriter{
+		w:   w,
+		cfg: cfg,
+	}, nil
+}
+
+func (w *htmlWriter) Write(q *model.Question) error {
+	r := newRecord(q, w.cfg)
+	w.answerKey = append(w.answerKey, strings.ToUpper(r.CorrectAnswer.String()))
+	return htmlQuestionTemplate.Execute(w.w, htmlQuestion{
+		Number:  len(w.answerKey),
+		Content: r.Content,
+		Image:   r.Image,
+		Answers: []htmlAnswer{
+			{"A", r.AnswerA, r.AnswerAImage},
+			{"B", r.AnswerB, r.AnswerBImage},
+			{"C", r.AnswerC, r.AnswerCImage},
+			{"D", r.AnswerD, r.AnswerDImage},
+		},
+	})
+}
+
+func (w *htmlWriter) Close() error {
+	return htmlFooterTemplate.Execute(w.w, w.answerKey)
+}
@@ -0,0 +1,343 @@
+// Package importer reads questions from CSV and JSON files, the images are taken from a zip archive.
+//
+// Both formats use the same fields: content, from, explanation, correctAnswer, answerA, answerB, answerC, answerD,
+// qualificationID, image, answerAImage, answerBImage, answerCImage and answerDImage.
+// The image fields hold paths of the images inside the archive.
+// A CSV file must start with a header, the JSON one must be an array of objects.
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+)
+
+const (
+	fieldContent         = "content"
+	fieldFrom            = "from"
+	fieldExplanation     = "explanation"
+	fieldCorrectAnswer   = "correctAnswer"
+	fieldAnswerA         = "answerA"
+	fieldAnswerB         = "answerB"
+	fieldAnswerC         = "answerC"
+	fieldAnswerD         = "answerD"
+	fieldQualificationID = "qualificationID"
+	fieldImage           = "image"
+	fieldAnswerAImage    = "answerAImage"
+	fieldAnswerBImage    = "answerBImage"
+	fieldAnswerCImage    = "answerCImage"
+	fieldAnswerDImage    = "answerDImage"
+)
+
+const (
+	// MaxImageSize is the maximum (uncompressed) size of an image in the archive.
+	MaxImageSize = 10 << 20
+	// MaxArchiveSize is the maximum size of an archive read by NewImageArchive.
+	MaxArchiveSize = 100 << 20
+)
+
+// utf8BOM is added by some spreadsheet editors at the beginning of CSV files.
+const utf8BOM = "\uFEFF"
+
+var (
+	fields = [...]string{
+		fieldContent,
+		fieldFrom,
+		fieldExplanation,
+		fieldCorrectAnswer,
+		fieldAnswerA,
+		fieldAnswerB,
+		fieldAnswerC,
+		fieldAnswerD,
+		fieldQualificationID,
+		fieldImage,
+		fieldAnswerAImage,
+		fieldAnswerBImage,
+		fieldAnswerCImage,
+		fieldAnswerDImage,
+	}
+	requiredFields = [...]string{
+		fieldContent,
+		fieldCorrectAnswer,
+		fieldQualificationID,
+	}
+)
+
+// FormatFromFilename guesses the format based on the extension of the file.
+func FormatFromFilename(filename string) (model.QuestionImportFormat, bool) {
+	format := model.QuestionImportFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), "."))
+	return format, format.IsValid()
+}
+
+// NewImageArchive reads the whole archive into memory, it's meant for archives that aren't seekable (e.g. uploads).
+// Archives bigger than MaxArchiveSize are rejected.
+func NewImageArchive(r io.Reader) (*zip.Reader, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, MaxArchiveSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, messageFailedToReadArchive)
+	}
+	if len(b) > MaxArchiveSize {
+		return nil, errors.Errorf(messageArchiveIsTooLarge, MaxArchiveSize>>20)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, errors.Wrap(err, messageFailedToReadArchive)
+	}
+	return archive, nil
+}
+
+// Parse reads the questions from data, images may be nil if none of the questions has an image.
+// An error is returned only if the whole file can't be read, problems with single rows are reported in ImportRow.Err.
+func Parse(format model.QuestionImportFormat, data io.Reader, images *zip.Reader) ([]*question.ImportRow, error) {
+	var records []map[string]string
+	var err error
+	switch format {
+	case model.QuestionImportFormatCSV:
+		records, err = readCSV(data)
+	case model.QuestionImportFormatJSON:
+		records, err = readJSON(data)
+	default:
+		return nil, errors.Errorf(messageUnsupportedFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*zip.File)
+	if images != nil {
+		for _, f := range images.File {
+			files[path.Clean(f.Name)] = f
+		}
+	}
+	rows := make([]*question.ImportRow, len(records))
+	for i, record := range records {
+		input, err := toQuestionInput(record, images != nil, files)
+		rows[i] = &question.ImportRow{
+			Number: i + 1,
+			Input:  input,
+			Err:    err,
+		}
+	}
+	return rows, nil
+}
+
+func readCSV(data io.Reader) ([]map[string]string, error) {
+	r := csv.NewReader(data)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, messageInvalidCSV)
+	}
+
+	columns := make([]string, len(header))
+	for i, column := range header {
+		columns[i] = findField(strings.TrimSpace(strings.TrimPrefix(column, utf8BOM)))
+		if columns[i] == "" {
+			return nil, errors.Errorf(messageInvalidCSVHeader, column)
+		}
+	}
+	for _, field := range requiredFields {
+		if !contains(columns, field) {
+			return nil, errors.Errorf(messageMissingCSVColumn, field)
+		}
+	}
+
+	var records []map[string]string
+	for {
+		values, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, messageInvalidCSV)
+		}
+		record := make(map[string]string, len(columns))
+		for i, value := range values {
+			record[columns[i]] = value
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func readJSON(data io.Reader) ([]map[string]string, error) {
+	var objects []map[string]interface{}
+	if err := json.NewDecoder(data).Decode(&objects); err != nil {
+		return nil, errors.Wrap(err, messageInvalidJSON)
+	}
+
+	records := make([]map[string]string, len(objects))
+	for i, object := range objects {
+		records[i] = make(map[string]string, len(object))
+		for key, value := range object {
+			field := findField(key)
+			if field == "" || value == nil {
+				continue
+			}
+			switch v := value.(type) {
+			case string:
+				records[i][field] = v
+			case float64:
+				records[i][field] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				records[i][field] = ""
+			}
+		}
+	}
+	return records, nil
+}
+
+func toQuestionInput(record map[string]string, hasArchive bool, files map[string]*zip.File) (*model.QuestionInput, error) {
+	input := &model.QuestionInput{
+		Content:     stringPointer(record[fieldContent], true),
+		From:        stringPointer(record[fieldFrom], false),
+		Explanation: stringPointer(record[fieldExplanation], false),
+		AnswerA:     stringPointer(record[fieldAnswerA], false),
+		AnswerB:     stringPointer(record[fieldAnswerB], false),
+		AnswerC:     stringPointer(record[fieldAnswerC], false),
+		AnswerD:     stringPointer(record[fieldAnswerD], false),
+	}
+
+	correctAnswer := model.Answer(strings.ToLower(strings.TrimSpace(record[fieldCorrectAnswer])))
+	input.CorrectAnswer = &correctAnswer
+
+	qualificationID, err := strconv.Atoi(strings.TrimSpace(record[fieldQualificationID]))
+	if err != nil {
+		return input, errors.Errorf(messageInvalidQualificationID, record[fieldQualificationID])
+	}
+	input.QualificationID = &qualificationID
+
+	images := [...]struct {
+		field       string
+		destination **graphql.Upload
+	}{
+		{fieldImage, &input.Image},
+		{fieldAnswerAImage, &input.AnswerAImage},
+		{fieldAnswerBImage, &input.AnswerBImage},
+		{fieldAnswerCImage, &input.AnswerCImage},
+		{fieldAnswerDImage, &input.AnswerDImage},
+	}
+	for _, image := range images {
+		name := strings.TrimSpace(record[image.field])
+		if name == "" {
+			continue
+		}
+		if !hasArchive {
+			return input, errors.Errorf(messageImageArchiveIsMissing, name)
+		}
+		upload, err := openImage(files, name)
+		if err != nil {
+			return input, err
+		}
+		*image.destination = upload
+	}
+
+	return input, nil
+}
+
+// openImage returns an upload that reads the image from the archive on the first read,
+// so that only the image being processed is held in memory.
+func openImage(files map[string]*zip.File, name string) (*graphql.Upload, error) {
+	f, ok := files[path.Clean(strings.TrimPrefix(name, "/"))]
+	if !ok {
+		return nil, errors.Errorf(messageImageNotFoundInArchive, name)
+	}
+	if f.UncompressedSize64 > MaxImageSize {
+		return nil, errors.Errorf(messageImageIsTooLarge, name, MaxImageSize>>20)
+	}
+	return &graphql.Upload{
+		File: &archiveImage{
+			file: f,
+			name: name,
+		},
+		Filename:    path.Base(f.Name),
+		Size:        int64(f.UncompressedSize64),
+		ContentType: mime.TypeByExtension(path.Ext(f.Name)),
+	}, nil
+}
+
+// archiveImage reads a file from the archive, it fails if the file turns out to be bigger than MaxImageSize
+// (the size in the header can't be trusted).
+type archiveImage struct {
+	file *zip.File
+	name string
+	rc   io.ReadCloser
+	r    io.Reader
+	read int64
+	// err is returned by all the reads after the file has been closed
+	err error
+}
+
+func (img *archiveImage) Read(p []byte) (int, error) {
+	if img.err != nil {
+		return 0, img.err
+	}
+	if img.rc == nil {
+		rc, err := img.file.Open()
+		if err != nil {
+			img.err = errors.Errorf(messageFailedToReadImage, img.name)
+			return 0, img.err
+		}
+		img.rc = rc
+		img.r = io.LimitReader(rc, MaxImageSize+1)
+	}
+
+	n, err := img.r.Read(p)
+	img.read += int64(n)
+	switch {
+	case img.read > MaxImageSize:
+		img.close(errors.Errorf(messageImageIsTooLarge, img.name, MaxImageSize>>20))
+		return 0, img.err
+	case err == io.EOF:
+		img.close(io.EOF)
+	case err != nil:
+		img.close(errors.Errorf(messageFailedToReadImage, img.name))
+		return n, img.err
+	}
+	return n, err
+}
+
+func (img *archiveImage) close(err error) {
+	_ = img.rc.Close()
+	img.err = err
+}
+
+// findField matches the column name case-insensitively, an empty string is returned for unknown columns.
+func findField(name string) string {
+	for _, field := range fields {
+		if strings.EqualFold(field, name) {
+			return field
+		}
+	}
+	return ""
+}
+
+func stringPointer(s string, keepEmpty bool) *string {
+	if s == "" && !keepEmpty {
+		return nil
+	}
+	return &s
+}
+
+func contains(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package importer
+
+const (
+	messageUnsupportedFormat      = "Nieobsługiwany format pliku: %s."
+	messageInvalidCSVHeader       = "Nieprawidłowy nagłówek pliku CSV: nieznana kolumna %s."
+	messageMissingCSVColumn       = "Nieprawidłowy nagłówek pliku CSV: brak kolumny %s."
+	messageInvalidCSV             = "Nie udało się odczytać pliku CSV."
+	messageInvalidJSON            = "Nie udało się odczytać pliku JSON."
+	messageInvalidQualificationID = "Nieprawidłowe ID kwalifikacji: %s."
+	messageImageArchiveIsMissing  = "Pytanie odwołuje się do obrazka %s, ale nie przesłano archiwum z obrazkami."
+	messageImageNotFoundInArchive = "Nie znaleziono obrazka %s w archiwum."
+	messageFailedToReadImage      = "Nie udało się odczytać obrazka %s z archiwum."
+	messageImageIsTooLarge        = "Obrazek %s w archiwum jest za duży (maksymalnie %d MB)."
+	messageFailedToReadArchive    = "Nie udało się odczytać archiwum z obrazkami."
+	messageArchiveIsTooLarge      = "Archiwum z obrazkami jest za duże (maksymalnie %d MB)."
+)
@@ -2,6 +2,7 @@ package question
 
 import (
 	"context"
+	"time"
 
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 )
@@ -17,6 +18,58 @@ type FetchConfig struct {
 type GenerateTestConfig struct {
 	Qualifications []int
 	Limit          int
+	// Seed determines which questions are drawn and in what order they're returned,
+	// the same seed and the same set of questions always give the same test.
+	// If it is zero, the usecase draws a random seed and stores it here.
+	Seed int
+	// Allocation is ignored in the exam mode, defaults to model.TestAllocationProportional.
+	Allocation model.TestAllocation
+	// Counts holds the number of questions for every qualification, it's required by model.TestAllocationExplicit.
+	Counts map[int]int
+	// Exam draws the questions proportionally across the exam sessions they come from (Question.From),
+	// so that the test resembles the real exam.
+	Exam bool
+}
+
+type IterateConfig struct {
+	Filter    *model.QuestionFilter
+	BatchSize int
+}
+
+// IterateFunc is called for every question, returning an error stops the iteration.
+type IterateFunc func(q *model.Question) error
+
+// ImportRow is a single question read from an import file.
+type ImportRow struct {
+	// Number is the 1-based position of the row in the file.
+	Number int
+	Input  *model.QuestionInput
+	// Err is set when the row couldn't be read (e.g. an image is missing in the archive).
+	Err error
+}
+
+type ImportConfig struct {
+	Rows []*ImportRow
+	// DryRun validates the rows and checks them against the database without saving anything.
+	DryRun bool
+}
+
+type ChangesConfig struct {
+	QualificationIDs []int
+	// Since lists only the changes made after the given time, it's ignored when the cursor is set.
+	Since time.Time
+	// Cursor is returned by the previous call, the changes are listed right after it.
+	Cursor string
+	Limit  int
+}
+
+type FetchChangesConfig struct {
+	QualificationIDs []int
+	// Since skips the changes made before the given time, it's meant for the first page only.
+	Since time.Time
+	// After lists the changes right after the cursor (all of them if it's nil).
+	After *ChangeCursor
+	Limit int
 }
 
 type Repository interface {
@@ -25,4 +78,12 @@ type Repository interface {
 	Delete(ctx context.Context, f *model.QuestionFilter) ([]*model.Question, error)
 	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.Question, int, error)
 	GenerateTest(ctx context.Context, cfg *GenerateTestConfig) ([]*model.Question, error)
+	// Iterate walks through the questions in the ID order, loading them in batches (keyset pagination),
+	// so that the memory usage doesn't depend on the number of questions.
+	Iterate(ctx context.Context, cfg *IterateConfig, fn IterateFunc) error
+	// Import stores the rows in a single transaction, a row that fails is skipped and doesn't affect the others.
+	Import(ctx context.Context, rows []*ImportRow, dryRun bool) ([]*model.QuestionImportRow, error)
+	// Changes lists the updates and the tombstones of the questions ordered the same way as the cursor,
+	// only the changes of the transactions that can't commit anymore are listed, so that none is skipped.
+	Changes(ctx context.Context, cfg *FetchChangesConfig) ([]*model.QuestionChange, error)
 }
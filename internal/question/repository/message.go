@@ -1,8 +1,12 @@
 package repository
 
 const (
-	messageSimilarRecordExists = "Istnieje już podobne pytanie."
-	messageFailedToSaveModel   = "Wystąpił błąd podczas zapisywania pytania."
-	messageFailedToDeleteModel = "Wystąpił błąd podczas usuwania pytania."
-	messageFailedToFetchModel  = "Wystąpił błąd podczas pobierania pytań."
+	messageSimilarRecordExists   = "Istnieje już podobne pytanie."
+	messageFailedToSaveModel     = "Wystąpił błąd podczas zapisywania pytania."
+	messageFailedToDeleteModel   = "Wystąpił błąd podczas usuwania pytania."
+	messageFailedToFetchModel    = "Wystąpił błąd podczas pobierania pytań."
+	messageFailedToImport        = "Wystąpił błąd podczas importowania pytań."
+	messageQualificationNotFound = "Wybrana kwalifikacja nie istnieje."
+	messageFailedToProcessImage  = "Wystąpił błąd podczas przetwarzania obrazka."
+	messageFailedToSaveImage     = "Wystąpił błąd podczas zapisywania obrazka."
 )
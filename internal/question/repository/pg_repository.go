@@ -8,16 +8,19 @@ import (
 	"time"
 
 	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
 
 	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/imageproc"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
 	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
 )
 
 type PGRepositoryConfig struct {
-	DB          *pg.DB
-	FileStorage fstorage.FileStorage
+	DB             *pg.DB
+	FileStorage    fstorage.FileStorage
+	ImageProcessor *imageproc.Processor
 }
 
 type PGRepository struct {
@@ -34,75 +37,121 @@ func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
 	if cfg.FileStorage == nil {
 		return nil, errors.New("cfg.FileStorage is required")
 	}
+	if cfg.ImageProcessor == nil {
+		return nil, errors.New("cfg.ImageProcessor is required")
+	}
 	return &PGRepository{
 		cfg.DB,
 		&repository{
-			fileStorage: cfg.FileStorage,
+			fileStorage:    cfg.FileStorage,
+			imageProcessor: cfg.ImageProcessor,
 		},
 	}, nil
 }
 
 func (repo *PGRepository) Store(ctx context.Context, input *model.QuestionInput) (*model.Question, error) {
+	images, err := repo.processImages(input)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := repo.stageImages(ctx, images.files())
+	if err != nil {
+		return nil, err
+	}
+
 	item := input.ToQuestion()
-	baseQuery := repo.
-		Model(item).
-		Context(ctx).
-		Returning("*")
-	if _, err := baseQuery.
-		Clone().
-		Insert(); err != nil {
-		return nil, handleInsertAndUpdateError(err)
-	}
-
-	repo.saveImages(item, input)
-	if _, err := baseQuery.
-		Clone().
-		WherePK().
-		Set("image = ?", item.Image).
-		Set("answer_a_image = ?", item.AnswerAImage).
-		Set("answer_b_image = ?", item.AnswerBImage).
-		Set("answer_c_image = ?", item.AnswerCImage).
-		Set("answer_d_image = ?", item.AnswerDImage).
-		Update(); err != nil && err != pg.ErrNoRows {
-		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	images.applyTo(item)
+	err = repo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if _, err := tx.
+			Model(item).
+			Context(ctx).
+			Returning("*").
+			Insert(); err != nil {
+			return handleInsertAndUpdateError(err)
+		}
+		return commitStagedImages(ctx, tx, staged)
+	})
+	if err != nil {
+		repo.rollbackStagedImages(ctx, staged)
+		return nil, err
 	}
 
 	return item, nil
 }
 
 func (repo *PGRepository) UpdateOneByID(ctx context.Context, id int, input *model.QuestionInput) (*model.Question, error) {
-	item := &model.Question{}
-	baseQuery := repo.
-		Model(item).
-		Context(ctx).
-		Returning("*").
-		Where(gopgutil.BuildConditionEquals("?"), gopgutil.AddAliasToColumnName("id", "question"), id).
-		Set("updated_at = ?", time.Now())
-
-	if _, err := baseQuery.
-		Clone().
-		Apply(input.ApplyUpdate).
-		Update(); err != nil && err != pg.ErrNoRows {
-		return nil, handleInsertAndUpdateError(err)
+	images, err := repo.processImages(input)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := repo.stageImages(ctx, images.files())
+	if err != nil {
+		return nil, err
 	}
 
-	repo.saveImages(item, input)
-	repo.deleteImagesBasedOnInput(item, input)
+	item := &model.Question{}
+	err = repo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		baseQuery := tx.
+			Model(item).
+			Context(ctx).
+			Returning("*").
+			Where(gopgutil.BuildConditionEquals("?"), gopgutil.AddAliasToColumnName("id", "question"), id)
 
-	if _, err := baseQuery.
-		Clone().
-		Set("image = ?", item.Image).
-		Set("answer_a_image = ?", item.AnswerAImage).
-		Set("answer_b_image = ?", item.AnswerBImage).
-		Set("answer_c_image = ?", item.AnswerCImage).
-		Set("answer_d_image = ?", item.AnswerDImage).
-		Update(); err != nil && err != pg.ErrNoRows {
-		return nil, handleInsertAndUpdateError(err)
+		// the row stays locked until the images are updated
+		if _, err := baseQuery.
+			Clone().
+			Set("updated_at = ?", time.Now()).
+			Apply(input.ApplyUpdate).
+			Update(); err != nil {
+			if err == pg.ErrNoRows {
+				item = nil
+				return errItemNotFound
+			}
+			return handleInsertAndUpdateError(err)
+		}
+
+		images.applyTo(item)
+		removeImagesBasedOnInput(item, input)
+		// the files of the replaced and the removed images are scheduled for deletion by a trigger
+		if _, err := baseQuery.
+			Clone().
+			Apply(setImages(item)).
+			Update(); err != nil {
+			return handleInsertAndUpdateError(err)
+		}
+		return commitStagedImages(ctx, tx, staged)
+	})
+	if err != nil {
+		repo.rollbackStagedImages(ctx, staged)
+		if err == errItemNotFound {
+			return nil, nil
+		}
+		return nil, err
 	}
 
 	return item, nil
 }
 
+// errItemNotFound rolls back the update of a question that doesn't exist.
+var errItemNotFound = errors.New("item not found")
+
+func setImages(item *model.Question) func(q *orm.Query) (*orm.Query, error) {
+	return func(q *orm.Query) (*orm.Query, error) {
+		variants := item.ImageVariants
+		if variants == nil {
+			variants = make(map[string][]*model.ImageVariant)
+		}
+		return q.
+			Set("image = ?", item.Image).
+			Set("answer_a_image = ?", item.AnswerAImage).
+			Set("answer_b_image = ?", item.AnswerBImage).
+			Set("answer_c_image = ?", item.AnswerCImage).
+			Set("answer_d_image = ?", item.AnswerDImage).
+			Set("image_variants = ?", variants), nil
+	}
+}
+
+// Delete removes the questions, the files of their images are scheduled for deletion by a trigger.
 func (repo *PGRepository) Delete(ctx context.Context, f *model.QuestionFilter) ([]*model.Question, error) {
 	items := make([]*model.Question, 0)
 	if _, err := repo.
@@ -114,8 +163,6 @@ func (repo *PGRepository) Delete(ctx context.Context, f *model.QuestionFilter) (
 		return nil, errorutil.Wrap(err, messageFailedToDeleteModel)
 	}
 
-	go repo.getAllImagesAndDelete(items)
-
 	return items, nil
 }
 
@@ -144,27 +191,344 @@ func (repo *PGRepository) Fetch(ctx context.Context, cfg *question.FetchConfig)
 	return items, total, nil
 }
 
-func (repo *PGRepository) GenerateTest(ctx context.Context, cfg *question.GenerateTestConfig) ([]*model.Question, error) {
-	subquery := repo.
+// seededOrderExpr is a deterministic replacement for random(), the rows are shuffled by the hash of the seed and the given column.
+const seededOrderExpr = "md5(?::text || ':' || ?::text)"
+
+func (repo *PGRepository) Iterate(ctx context.Context, cfg *question.IterateConfig, fn question.IterateFunc) error {
+	lastID := 0
+	for {
+		items := make([]*model.Question, 0, cfg.BatchSize)
+		if err := repo.
+			Model(&items).
+			Context(ctx).
+			Where(gopgutil.BuildConditionGT("?"), gopgutil.AddAliasToColumnName("id", "question"), lastID).
+			Apply(cfg.Filter.Where).
+			Order("question.id ASC").
+			Limit(cfg.BatchSize).
+			Select(); err != nil && err != pg.ErrNoRows {
+			return errorutil.Wrap(err, messageFailedToFetchModel)
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if len(items) < cfg.BatchSize {
+			return nil
+		}
+		lastID = items[len(items)-1].ID
+	}
+}
+
+func (repo *PGRepository) Changes(ctx context.Context, cfg *question.FetchChangesConfig) ([]*model.QuestionChange, error) {
+	updates := repo.
 		Model(&model.Question{}).
-		Column("id").
-		Where(gopgutil.BuildConditionArray("qualification_id"), pg.Array(cfg.Qualifications)).
-		OrderExpr("random()").
+		ColumnExpr(
+			"? AS question_id, ? AS change_txid, ? AS changed_at, false AS deleted",
+			pg.Ident("id"),
+			pg.Ident("change_txid"),
+			pg.Ident("updated_at"),
+		)
+	tombstones := repo.
+		Model(&model.QuestionTombstone{}).
+		ColumnExpr(
+			"? AS question_id, ? AS change_txid, ? AS changed_at, true AS deleted",
+			pg.Ident("question_id"),
+			pg.Ident("change_txid"),
+			pg.Ident("deleted_at"),
+		)
+	if len(cfg.QualificationIDs) > 0 {
+		updates = updates.Where(gopgutil.BuildConditionArray("qualification_id"), pg.Array(cfg.QualificationIDs))
+		tombstones = tombstones.Where(gopgutil.BuildConditionArray("qualification_id"), pg.Array(cfg.QualificationIDs))
+	}
+
+	query := repo.
+		Model().
+		Context(ctx).
+		TableExpr("(?) AS change", updates.UnionAll(tombstones)).
+		Column("change.question_id", "change.change_txid", "change.changed_at", "change.deleted").
+		// every transaction below xmin of the snapshot has already finished,
+		// the ones still in progress are listed once they commit
+		Where("change.change_txid < txid_snapshot_xmin(txid_current_snapshot())").
+		Order("change.change_txid ASC", "change.question_id ASC", "change.deleted ASC").
 		Limit(cfg.Limit)
+	if cfg.After != nil {
+		query = query.Where(
+			"(change.change_txid, change.question_id, change.deleted) > (?, ?, ?)",
+			cfg.After.TxID,
+			cfg.After.QuestionID,
+			cfg.After.Deleted,
+		)
+	} else if !cfg.Since.IsZero() {
+		query = query.Where("change.changed_at > ?", cfg.Since)
+	}
+
+	changes := make([]*model.QuestionChange, 0)
+	if err := query.Select(&changes); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return changes, nil
+}
+
+func (repo *PGRepository) GenerateTest(ctx context.Context, cfg *question.GenerateTestConfig) ([]*model.Question, error) {
+	var subquery *orm.Query
+	if cfg.Exam {
+		subquery = repo.buildExamSubquery(cfg)
+	} else {
+		subquery = repo.buildSampleSubquery(cfg)
+	}
 	items := make([]*model.Question, 0)
 	if err := repo.
 		Model(&items).
 		Context(ctx).
 		Where(gopgutil.BuildConditionIn("id"), subquery).
+		OrderExpr(seededOrderExpr, cfg.Seed, pg.Ident("question.id")).
 		Select(); err != nil && err != pg.ErrNoRows {
 		return nil, errorutil.Wrap(err, messageFailedToFetchModel)
 	}
 	return items, nil
 }
 
+const (
+	// sampleStartPoints is the number of the seeded points the windows of random keys start at.
+	sampleStartPoints = 4
+	// sampleWindowFactor is the number of candidates read per question to draw, they're shuffled by the hash of the seed
+	// and their ID, so the more candidates, the closer the sample is to a uniform one.
+	sampleWindowFactor = 16
+)
+
+// sampleWindowQuery takes window_size questions of a single qualification whose random keys come right after the point,
+// the window wraps around to the beginning of the range if there are too few questions after the point.
+// Both parts are range scans of the index on (qualification_id, random_key) limited to window_size rows,
+// so the cost depends on the number of questions to draw, not on the size of the qualification
+// (TestGenerateTestSamplingUsesIndex checks the plan with EXPLAIN).
+const sampleWindowQuery = `(
+	SELECT window_row.id, window_row.distance FROM (
+		(SELECT id, random_key - point.value AS distance FROM questions
+		WHERE qualification_id = qualification.id AND random_key >= point.value ORDER BY random_key LIMIT qualification.window_size)
+		UNION ALL
+		(SELECT id, random_key - point.value + 1 AS distance FROM questions
+		WHERE qualification_id = qualification.id AND random_key < point.value ORDER BY random_key LIMIT qualification.window_size)
+	) AS window_row
+	ORDER BY window_row.distance
+	LIMIT qualification.window_size
+)`
+
+// buildSampleSubquery reads the windows of random keys starting at several points derived from the seed
+// and shuffles the candidates by the hash of the seed and their ID.
+// In the proportional mode the windows of all qualifications are merged (as if they were a single index),
+// so every question of the selected qualifications has the same chance to be drawn.
+// The equal mode takes the questions in turns from every qualification
+// and the explicit one cuts every qualification to the requested number of questions.
+func (repo *PGRepository) buildSampleSubquery(cfg *question.GenerateTestConfig) *orm.Query {
+	quotas := make([]int, len(cfg.Qualifications))
+	windowSizes := make([]int, len(cfg.Qualifications))
+	for i, qualificationID := range cfg.Qualifications {
+		quotas[i] = cfg.Limit
+		if cfg.Allocation == model.TestAllocationExplicit {
+			quotas[i] = cfg.Counts[qualificationID]
+		}
+		windowSizes[i] = sampleWindowSize(quotas[i])
+	}
+	candidates := repo.
+		Model().
+		TableExpr(
+			"unnest(?::bigint[], ?::bigint[], ?::bigint[]) AS qualification(id, quota, window_size)",
+			pg.Array(cfg.Qualifications),
+			pg.Array(quotas),
+			pg.Array(windowSizes),
+		).
+		TableExpr("unnest(?::double precision[]) WITH ORDINALITY AS point(value, n)", pg.Array(seedToStartPoints(cfg.Seed))).
+		TableExpr("LATERAL ? AS sample", pg.SafeQuery(sampleWindowQuery)).
+		Column("sample.id").
+		ColumnExpr("qualification.id AS qualification_id").
+		Column("qualification.quota").
+		ColumnExpr("row_number() OVER (PARTITION BY point.n ORDER BY sample.distance) AS point_rank")
+
+	pool := repo.
+		Model().
+		TableExpr("(?) AS candidate", candidates).
+		Column("candidate.id", "candidate.qualification_id", "candidate.quota").
+		Distinct()
+	if cfg.Allocation != model.TestAllocationEqual && cfg.Allocation != model.TestAllocationExplicit {
+		pool = pool.Where("candidate.point_rank <= ?", sampleWindowSize(cfg.Limit))
+	}
+
+	sample := repo.
+		Model().
+		TableExpr("(?) AS pool", pool).
+		Column("pool.id", "pool.quota").
+		ColumnExpr(seededOrderExpr+" AS sort_key", cfg.Seed, pg.Ident("pool.id")).
+		ColumnExpr(
+			"row_number() OVER (PARTITION BY pool.qualification_id ORDER BY "+seededOrderExpr+") AS position",
+			cfg.Seed,
+			pg.Ident("pool.id"),
+		)
+
+	query := repo.
+		Model().
+		TableExpr("(?) AS sample", sample).
+		Column("sample.id").
+		Limit(cfg.Limit)
+	switch cfg.Allocation {
+	case model.TestAllocationEqual:
+		query = query.Order("sample.position ASC", "sample.sort_key ASC")
+	case model.TestAllocationExplicit:
+		query = query.
+			Where("sample.position <= sample.quota").
+			Order("sample.sort_key ASC")
+	default:
+		query = query.Order("sample.sort_key ASC")
+	}
+	return query
+}
+
+// sampleWindowSize returns the number of questions read after every start point to draw quota questions.
+func sampleWindowSize(quota int) int {
+	return (quota*sampleWindowFactor + sampleStartPoints - 1) / sampleStartPoints
+}
+
+// seedToStartPoints maps the seed to sampleStartPoints points from the range [0, 1) (splitmix64 finalizer).
+func seedToStartPoints(seed int) []float64 {
+	points := make([]float64, sampleStartPoints)
+	for i := range points {
+		z := uint64(seed)*sampleStartPoints + uint64(i) + 0x9e3779b97f4a7c15
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+		points[i] = float64(z>>11) / (1 << 53)
+	}
+	return points
+}
+
+// buildExamSubquery shuffles the questions within each source (Question.From) and orders them by their relative position
+// in the source, so that the first N questions are spread across the sources proportionally to their size.
+func (repo *PGRepository) buildExamSubquery(cfg *question.GenerateTestConfig) *orm.Query {
+	pool := repo.
+		Model(&model.Question{}).
+		Column("id").
+		ColumnExpr(
+			"row_number() OVER (PARTITION BY coalesce(?, '') ORDER BY "+seededOrderExpr+") AS position",
+			pg.Ident("from"),
+			cfg.Seed,
+			pg.Ident("id"),
+		).
+		ColumnExpr("count(*) OVER (PARTITION BY coalesce(?, '')) AS source_size", pg.Ident("from")).
+		Where(gopgutil.BuildConditionArray("qualification_id"), pg.Array(cfg.Qualifications))
+	return repo.
+		Model().
+		TableExpr("(?) AS pool", pool).
+		Column("pool.id").
+		OrderExpr("(pool.position - 0.5) / pool.source_size ASC").
+		OrderExpr(seededOrderExpr, cfg.Seed, pg.Ident("pool.id")).
+		Limit(cfg.Limit)
+}
+
+func (repo *PGRepository) Import(
+	ctx context.Context,
+	rows []*question.ImportRow,
+	dryRun bool,
+) ([]*model.QuestionImportRow, error) {
+	var results []*model.QuestionImportRow
+	var staged stagedImages
+	err := repo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		results = make([]*model.QuestionImportRow, 0, len(rows))
+		for _, row := range rows {
+			result, images, err := repo.importRow(ctx, tx, row, dryRun)
+			staged = append(staged, images...)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && err != errDryRun {
+		repo.rollbackStagedImages(ctx, staged)
+		return nil, errorutil.Wrap(err, messageFailedToImport)
+	}
+	return results, nil
+}
+
+// errDryRun rolls back the import transaction.
+var errDryRun = errors.New("dry run")
+
+// importRow inserts the row within a savepoint, so that a failed row can be rolled back without aborting the whole transaction.
+// It returns the staged images of the inserted row, an error is returned only if the transaction can't be continued.
+// The images aren't uploaded in the dry run mode.
+func (repo *PGRepository) importRow(
+	ctx context.Context,
+	tx *pg.Tx,
+	row *question.ImportRow,
+	dryRun bool,
+) (*model.QuestionImportRow, stagedImages, error) {
+	result := &model.QuestionImportRow{
+		Row: row.Number,
+	}
+	images, err := repo.processImages(row.Input)
+	var staged stagedImages
+	if err == nil && !dryRun {
+		staged, err = repo.stageImages(ctx, images.files())
+	}
+	if err != nil {
+		result.Status = model.QuestionImportStatusError
+		result.Error = err.Error()
+		return result, nil, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT question_import"); err != nil {
+		return nil, staged, err
+	}
+	item := row.Input.ToQuestion()
+	images.applyTo(item)
+	result.Status = model.QuestionImportStatusError
+	_, err = tx.
+		ModelContext(ctx, item).
+		Returning("*").
+		Insert()
+	if err != nil {
+		if isDuplicateError(err) {
+			result.Status = model.QuestionImportStatusDuplicate
+		}
+		err = handleInsertAndUpdateError(err)
+	} else {
+		err = commitStagedImages(ctx, tx, staged)
+	}
+	if err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT question_import"); rollbackErr != nil {
+			return nil, staged, rollbackErr
+		}
+		repo.rollbackStagedImages(ctx, staged)
+		result.Error = err.Error()
+		return result, nil, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT question_import"); err != nil {
+		return nil, staged, err
+	}
+	result.Status = model.QuestionImportStatusCreated
+	if dryRun {
+		return result, nil, nil
+	}
+	result.QuestionID = &item.ID
+	return result, staged, nil
+}
+
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "questions_from_content_correct_answer_qualification_id_key")
+}
+
 func handleInsertAndUpdateError(err error) error {
-	if strings.Contains(err.Error(), "questions_from_content_correct_answer_qualification_id_key") {
+	if isDuplicateError(err) {
 		return errorutil.Wrap(err, messageSimilarRecordExists)
 	}
+	if strings.Contains(err.Error(), "questions_qualification_id_fkey") {
+		return errorutil.Wrap(err, messageQualificationNotFound)
+	}
 	return errorutil.Wrap(err, messageFailedToSaveModel)
 }
@@ -1,129 +1,173 @@
 package repository
 
 import (
-	"path/filepath"
-
-	"github.com/zdam-egzamin-zawodowy/backend/fstorage/fstorageutil"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
 
 	"github.com/zdam-egzamin-zawodowy/backend/fstorage"
+	"github.com/zdam-egzamin-zawodowy/backend/fstorage/fstorageutil"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/imageproc"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
 )
 
 type repository struct {
-	fileStorage fstorage.FileStorage
+	fileStorage    fstorage.FileStorage
+	imageProcessor *imageproc.Processor
 }
 
-func (repo *repository) saveImages(destination *model.Question, input *model.QuestionInput) {
-	images := [...]*graphql.Upload{
+type pendingImage struct {
+	file     io.Reader
+	filename string
+}
+
+// newImage is a processed upload along with the generated filenames of the image and its variants.
+type newImage struct {
+	filename string
+	variants []*model.ImageVariant
+	files    []pendingImage
+}
+
+// newImages holds the processed uploads in the order of the image fields
+// (the question image and the images of the answers A-D), nil means that the image hasn't been uploaded.
+type newImages [5]*newImage
+
+// files returns the files of all the images, they should be staged before the question is saved.
+func (images newImages) files() []pendingImage {
+	var files []pendingImage
+	for _, image := range images {
+		if image != nil {
+			files = append(files, image.files...)
+		}
+	}
+	return files
+}
+
+// applyTo replaces the images of the question with the new ones,
+// the files of the replaced images are scheduled for deletion by the database once the question is saved.
+func (images newImages) applyTo(question *model.Question) {
+	filenames := imageFilenames(question)
+	for index, image := range images {
+		if image == nil {
+			continue
+		}
+		removeImage(question, filenames[index])
+		*filenames[index] = image.filename
+		if len(image.variants) > 0 {
+			if question.ImageVariants == nil {
+				question.ImageVariants = make(map[string][]*model.ImageVariant)
+			}
+			question.ImageVariants[image.filename] = image.variants
+		}
+	}
+}
+
+func imageUploads(input *model.QuestionInput) [5]*graphql.Upload {
+	return [...]*graphql.Upload{
 		input.Image,
 		input.AnswerAImage,
 		input.AnswerBImage,
 		input.AnswerCImage,
 		input.AnswerDImage,
 	}
-	filenames := [...]string{
-		destination.Image,
-		destination.AnswerAImage,
-		destination.AnswerBImage,
-		destination.AnswerCImage,
-		destination.AnswerDImage,
+}
+
+func imageFilenames(question *model.Question) [5]*string {
+	return [...]*string{
+		&question.Image,
+		&question.AnswerAImage,
+		&question.AnswerBImage,
+		&question.AnswerCImage,
+		&question.AnswerDImage,
 	}
+}
 
-	for index, file := range images {
-		if file != nil {
-			generated := false
-			if filenames[index] == "" {
-				generated = true
-				filenames[index] = fstorageutil.GenerateFilename(filepath.Ext(file.Filename))
-			}
-			err := repo.fileStorage.Put(file.File, filenames[index])
-			if err != nil && generated {
-				filenames[index] = ""
-			}
+// processImages converts the uploaded images and generates their filenames, it should be called before anything
+// is written to the database or the storage, so that an image that can't be processed doesn't leave anything behind.
+func (repo *repository) processImages(input *model.QuestionInput) (newImages, error) {
+	var images newImages
+	for index, upload := range imageUploads(input) {
+		if upload == nil {
+			continue
 		}
+		result, err := repo.imageProcessor.Process(upload.File)
+		if err != nil {
+			return images, errorutil.Wrap(err, messageFailedToProcessImage)
+		}
+		images[index] = newImageFromResult(result)
 	}
-
-	destination.Image = filenames[0]
-	destination.AnswerAImage = filenames[1]
-	destination.AnswerBImage = filenames[2]
-	destination.AnswerCImage = filenames[3]
-	destination.AnswerDImage = filenames[4]
+	return images, nil
 }
 
-func (repo *repository) deleteImages(images []string) {
-	for _, image := range images {
-		repo.fileStorage.Remove(image)
+// newImageFromResult generates the filenames of the processed image and its variants,
+// the variants are named after the image, e.g. <uuid>-480w.webp.
+func newImageFromResult(result *imageproc.Result) *newImage {
+	image := &newImage{
+		filename: fstorageutil.GenerateFilename(imageproc.Extension),
+		variants: make([]*model.ImageVariant, 0, len(result.Variants)),
+	}
+	image.files = append(image.files, pendingImage{
+		file:     bytes.NewReader(result.Image.Data),
+		filename: image.filename,
+	})
+	for _, processed := range result.Variants {
+		variant := &model.ImageVariant{
+			Filename: fmt.Sprintf(
+				"%s-%dw%s",
+				strings.TrimSuffix(image.filename, imageproc.Extension),
+				processed.Width,
+				imageproc.Extension,
+			),
+			Width:  processed.Width,
+			Height: processed.Height,
+		}
+		image.variants = append(image.variants, variant)
+		image.files = append(image.files, pendingImage{
+			file:     bytes.NewReader(processed.Data),
+			filename: variant.Filename,
+		})
 	}
+	return image
 }
 
-func (repo *repository) deleteImagesBasedOnInput(question *model.Question, input *model.QuestionInput) {
-	images := []string{}
+// removeImage unsets the image (pointed by field) of the question along with its variants.
+func removeImage(question *model.Question, field *string) {
+	delete(question.ImageVariants, *field)
+	*field = ""
+}
 
+func removeImagesBasedOnInput(question *model.Question, input *model.QuestionInput) {
 	if input.DeleteImage != nil &&
 		*input.DeleteImage &&
-		input.Image == nil &&
-		question.Image != "" {
-		images = append(images, question.Image)
-		question.Image = ""
+		input.Image == nil {
+		removeImage(question, &question.Image)
 	}
 
 	if input.DeleteAnswerAImage != nil &&
 		*input.DeleteAnswerAImage &&
-		input.AnswerAImage == nil &&
-		question.AnswerAImage != "" {
-		images = append(images, question.AnswerAImage)
-		question.AnswerAImage = ""
+		input.AnswerAImage == nil {
+		removeImage(question, &question.AnswerAImage)
 	}
 
 	if input.DeleteAnswerBImage != nil &&
 		*input.DeleteAnswerBImage &&
-		input.AnswerBImage == nil &&
-		question.AnswerBImage != "" {
-		images = append(images, question.AnswerBImage)
-		question.AnswerBImage = ""
+		input.AnswerBImage == nil {
+		removeImage(question, &question.AnswerBImage)
 	}
 
 	if input.DeleteAnswerCImage != nil &&
 		*input.DeleteAnswerCImage &&
-		input.AnswerCImage == nil &&
-		question.AnswerCImage != "" {
-		images = append(images, question.AnswerCImage)
-		question.AnswerCImage = ""
+		input.AnswerCImage == nil {
+		removeImage(question, &question.AnswerCImage)
 	}
 
 	if input.DeleteAnswerDImage != nil &&
 		*input.DeleteAnswerDImage &&
-		input.AnswerDImage == nil &&
-		question.AnswerDImage != "" {
-		images = append(images, question.AnswerDImage)
-		question.AnswerDImage = ""
-	}
-
-	repo.deleteImages(images)
-}
-
-func (repo *repository) getAllImagesAndDelete(questions []*model.Question) {
-	images := []string{}
-
-	for _, question := range questions {
-		if question.Image != "" {
-			images = append(images, question.Image)
-		}
-		if question.AnswerAImage != "" {
-			images = append(images, question.AnswerAImage)
-		}
-		if question.AnswerBImage != "" {
-			images = append(images, question.AnswerBImage)
-		}
-		if question.AnswerCImage != "" {
-			images = append(images, question.AnswerCImage)
-		}
-		if question.AnswerDImage != "" {
-			images = append(images, question.AnswerDImage)
-		}
+		input.AnswerDImage == nil {
+		removeImage(question, &question.AnswerDImage)
 	}
-
-	repo.deleteImages(images)
 }
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+// stagedImageLifetime is the time after which a staged image that hasn't been committed is removed by the file deletion worker.
+const stagedImageLifetime = time.Hour
+
+// stagedImages are the images uploaded before the question referencing them is saved.
+// Their deletion is scheduled before they're uploaded, so that they're removed by the file deletion worker
+// if the question is never saved (even if the process dies in the meantime).
+type stagedImages []string
+
+// stageImages schedules the deletion of the images and uploads them, the deletion is canceled by commitStagedImages.
+// If any of the images can't be uploaded, the already uploaded ones are removed.
+func (repo *PGRepository) stageImages(ctx context.Context, images []pendingImage) (stagedImages, error) {
+	if len(images) == 0 {
+		return nil, nil
+	}
+	staged := make(stagedImages, len(images))
+	for i, image := range images {
+		staged[i] = image.filename
+	}
+	deletions := model.NewFileDeletions(staged, time.Now().Add(stagedImageLifetime))
+	if _, err := repo.
+		Model(&deletions).
+		Context(ctx).
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveImage)
+	}
+	for _, image := range images {
+		if err := repo.fileStorage.Put(image.file, image.filename); err != nil {
+			repo.rollbackStagedImages(ctx, staged)
+			return nil, errorutil.Wrap(err, messageFailedToSaveImage)
+		}
+	}
+	return staged, nil
+}
+
+// commitStagedImages cancels the scheduled deletion of the staged images, it has to be called within the transaction
+// that saves the question. It fails if the worker has already claimed any of the deletions.
+func commitStagedImages(ctx context.Context, tx *pg.Tx, staged stagedImages) error {
+	if len(staged) == 0 {
+		return nil
+	}
+	res, err := tx.
+		Model(&model.FileDeletion{}).
+		Context(ctx).
+		Where(gopgutil.BuildConditionArray("filename"), pg.Array([]string(staged))).
+		Where(gopgutil.BuildConditionEquals("attempts"), 0).
+		Delete()
+	if err != nil {
+		return errorutil.Wrap(err, messageFailedToSaveImage)
+	}
+	if res.RowsAffected() != len(staged) {
+		return errorutil.Wrap(errors.New("the staged images have expired"), messageFailedToSaveImage)
+	}
+	return nil
+}
+
+// rollbackStagedImages removes the staged images right away, the ones that can't be removed are left to the worker.
+func (repo *PGRepository) rollbackStagedImages(ctx context.Context, staged stagedImages) {
+	removed := make([]string, 0, len(staged))
+	for _, filename := range staged {
+		if err := repo.fileStorage.Remove(filename); err == nil {
+			removed = append(removed, filename)
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+	_, _ = repo.
+		Model(&model.FileDeletion{}).
+		Context(ctx).
+		Where(gopgutil.BuildConditionArray("filename"), pg.Array(removed)).
+		Delete()
+}
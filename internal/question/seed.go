@@ -0,0 +1,16 @@
+package question
+
+import (
+	"crypto/rand"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// GenerateSeed returns a random seed in the range [1, MaxSeed].
+func GenerateSeed() (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(MaxSeed))
+	if err != nil {
+		return 0, errors.Wrap(err, "couldn't generate a seed")
+	}
+	return int(n.Int64()) + 1, nil
+}
@@ -13,4 +13,8 @@ type Usecase interface {
 	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.Question, int, error)
 	GetByID(ctx context.Context, id int) (*model.Question, error)
 	GenerateTest(ctx context.Context, cfg *GenerateTestConfig) ([]*model.Question, error)
+	Iterate(ctx context.Context, cfg *IterateConfig, fn IterateFunc) error
+	Import(ctx context.Context, cfg *ImportConfig) (*model.QuestionImportResult, error)
+	// Changes returns the questions updated and deleted since the last sync, a question appears at most once per page.
+	Changes(ctx context.Context, cfg *ChangesConfig) (*model.QuestionChanges, error)
 }
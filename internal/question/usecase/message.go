@@ -8,6 +8,15 @@ const (
 	messageCorrectAnswerIsInvalid            = "Odpowiedź poprawna na pytanie jest nieprawidłowa."
 	messageQualificationIDIsRequired         = "ID kwalifikacji jest wymagane."
 	messageAnswerIsRequired                  = "Odpowiedź %s jest wymagana."
-	messageImageNotAcceptableMIMEType        = "%s: Oczekiwany jest obrazek w formacie png or jpg."
+	messageImageNotAcceptableMIMEType        = "%s: Oczekiwany jest obrazek w formacie png, jpg, gif lub webp."
+	messageImageTooLarge                     = "%s: Obrazek ma zbyt dużą rozdzielczość."
 	messageCannotDeleteImageWithoutNewAnswer = "%s: Nie możesz usunąć obrazka i nie wprowadzić żadnej odpowiedzi."
+	messageInvalidAllocation                 = "Nieprawidłowy sposób podziału pytań."
+	messageCountsAreRequired                 = "Podaj liczbę pytań dla każdej kwalifikacji."
+	messageInvalidCount                      = "Liczba pytań dla kwalifikacji musi być większa od 0."
+	messageTooManyQuestions                  = "Test może zawierać maksymalnie %d pytań."
+	messageNothingToImport                   = "Brak pytań do zaimportowania."
+	messageTooManyRowsToImport               = "Jednorazowo można zaimportować maksymalnie %d pytań."
+	messageInvalidSeed                       = "Ziarno musi być liczbą z przedziału od 1 do %d."
+	messageInvalidCursor                     = "Niepoprawny kursor synchronizacji."
 )
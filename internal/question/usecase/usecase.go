@@ -3,25 +3,23 @@ package usecase
 import (
 	"context"
 	"github.com/pkg/errors"
+	"sort"
 
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/imageproc"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
 	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
 )
 
-var (
-	imageValidMIMETypes = map[string]bool{
-		"image/jpeg": true,
-		"image/jpg":  true,
-		"image/png":  true,
-	}
-)
-
 type Config struct {
 	QuestionRepository question.Repository
+	ImageProcessor     *imageproc.Processor
 }
 
 type Usecase struct {
 	questionRepository question.Repository
+	imageProcessor     *imageproc.Processor
 }
 
 var _ question.Usecase = &Usecase{}
@@ -30,8 +28,12 @@ func New(cfg *Config) (*Usecase, error) {
 	if cfg == nil || cfg.QuestionRepository == nil {
 		return nil, errors.New("cfg.QuestionRepository is required")
 	}
+	if cfg.ImageProcessor == nil {
+		return nil, errors.New("cfg.ImageProcessor is required")
+	}
 	return &Usecase{
 		cfg.QuestionRepository,
+		cfg.ImageProcessor,
 	}, nil
 }
 
@@ -39,6 +41,9 @@ func (ucase *Usecase) Store(ctx context.Context, input *model.QuestionInput) (*m
 	if err := validateInput(input.Sanitize(), validateOptions{false}); err != nil {
 		return nil, err
 	}
+	if err := ucase.validateImages(input); err != nil {
+		return nil, err
+	}
 	return ucase.questionRepository.Store(ctx, input)
 }
 
@@ -49,6 +54,9 @@ func (ucase *Usecase) UpdateOneByID(ctx context.Context, id int, input *model.Qu
 	if err := validateInput(input.Sanitize(), validateOptions{true}); err != nil {
 		return nil, err
 	}
+	if err := ucase.validateImages(input); err != nil {
+		return nil, err
+	}
 	item, err := ucase.questionRepository.UpdateOneByID(ctx,
 		id,
 		input)
@@ -104,12 +112,179 @@ func (ucase *Usecase) GenerateTest(ctx context.Context, cfg *question.GenerateTe
 			Limit: question.TestMaxLimit,
 		}
 	}
-	if cfg.Limit > question.TestMaxLimit {
+	if cfg.Allocation == "" {
+		cfg.Allocation = model.TestAllocationProportional
+	}
+	if !cfg.Allocation.IsValid() {
+		return nil, errors.New(messageInvalidAllocation)
+	}
+	if cfg.Allocation == model.TestAllocationExplicit && !cfg.Exam {
+		if err := applyExplicitAllocation(cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Limit > question.TestMaxLimit || cfg.Limit <= 0 {
 		cfg.Limit = question.TestMaxLimit
 	}
+	if cfg.Seed < 0 || cfg.Seed > question.MaxSeed {
+		return nil, errors.Errorf(messageInvalidSeed, question.MaxSeed)
+	}
+	if cfg.Seed == 0 {
+		seed, err := question.GenerateSeed()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Seed = seed
+	}
 	return ucase.questionRepository.GenerateTest(ctx, cfg)
 }
 
+func (ucase *Usecase) Iterate(ctx context.Context, cfg *question.IterateConfig, fn question.IterateFunc) error {
+	if cfg == nil {
+		cfg = &question.IterateConfig{}
+	}
+	if cfg.BatchSize <= 0 || cfg.BatchSize > question.FetchMaxLimit {
+		cfg.BatchSize = question.IterateBatchSize
+	}
+	return ucase.questionRepository.Iterate(ctx, cfg, fn)
+}
+
+func (ucase *Usecase) Changes(ctx context.Context, cfg *question.ChangesConfig) (*model.QuestionChanges, error) {
+	if cfg == nil {
+		cfg = &question.ChangesConfig{}
+	}
+	if cfg.Limit <= 0 {
+		cfg.Limit = question.ChangesDefaultLimit
+	}
+	if cfg.Limit > question.ChangesMaxLimit {
+		cfg.Limit = question.ChangesMaxLimit
+	}
+
+	var after *question.ChangeCursor
+	if cfg.Cursor != "" {
+		var err error
+		after, err = question.ParseChangeCursor(cfg.Cursor)
+		if err != nil {
+			return nil, errors.New(messageInvalidCursor)
+		}
+	}
+
+	// one more change is fetched to find out whether there is the next page
+	changes, err := ucase.questionRepository.Changes(ctx, &question.FetchChangesConfig{
+		QualificationIDs: cfg.QualificationIDs,
+		Since:            cfg.Since,
+		After:            after,
+		Limit:            cfg.Limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &model.QuestionChanges{
+		Updated:    []*model.Question{},
+		DeletedIDs: []int{},
+		HasMore:    len(changes) > cfg.Limit,
+	}
+	if result.HasMore {
+		changes = changes[:cfg.Limit]
+	}
+	if len(changes) > 0 {
+		after = question.NewChangeCursor(changes[len(changes)-1])
+	}
+	switch {
+	case after != nil:
+		result.Cursor = after.String()
+	case cfg.Since.IsZero():
+		result.Cursor = (&question.ChangeCursor{}).String()
+	default:
+		// the cursor can't be derived from the time, the client has to pass the same time again
+		result.Cursor = ""
+	}
+
+	// only the latest change of a question matters (e.g. it could have been moved to another qualification and back)
+	latest := make(map[int]*model.QuestionChange, len(changes))
+	for _, change := range changes {
+		latest[change.QuestionID] = change
+	}
+	var updatedIDs []int
+	for _, change := range changes {
+		if latest[change.QuestionID] != change {
+			continue
+		}
+		if change.Deleted {
+			result.DeletedIDs = append(result.DeletedIDs, change.QuestionID)
+		} else {
+			updatedIDs = append(updatedIDs, change.QuestionID)
+		}
+	}
+	if len(updatedIDs) == 0 {
+		return result, nil
+	}
+
+	questions, _, err := ucase.questionRepository.Fetch(ctx, &question.FetchConfig{
+		Limit: len(updatedIDs),
+		Filter: &model.QuestionFilter{
+			ID: updatedIDs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	questionByID := make(map[int]*model.Question, len(questions))
+	for _, q := range questions {
+		questionByID[q.ID] = q
+	}
+	for _, id := range updatedIDs {
+		// a question deleted in the meantime will be listed with its tombstone on one of the next pages
+		if q, ok := questionByID[id]; ok {
+			result.Updated = append(result.Updated, q)
+		}
+	}
+	return result, nil
+}
+
+func (ucase *Usecase) Import(ctx context.Context, cfg *question.ImportConfig) (*model.QuestionImportResult, error) {
+	if cfg == nil || len(cfg.Rows) == 0 {
+		return nil, errors.New(messageNothingToImport)
+	}
+	if len(cfg.Rows) > question.ImportMaxRows {
+		return nil, errors.Errorf(messageTooManyRowsToImport, question.ImportMaxRows)
+	}
+
+	results := make([]*model.QuestionImportRow, 0, len(cfg.Rows))
+	validRows := make([]*question.ImportRow, 0, len(cfg.Rows))
+	for _, row := range cfg.Rows {
+		err := row.Err
+		if err == nil {
+			err = validateInput(row.Input.Sanitize(), validateOptions{false})
+		}
+		if err == nil {
+			err = ucase.validateImages(row.Input)
+		}
+		if err != nil {
+			results = append(results, &model.QuestionImportRow{
+				Row:    row.Number,
+				Status: model.QuestionImportStatusError,
+				Error:  err.Error(),
+			})
+			continue
+		}
+		validRows = append(validRows, row)
+	}
+
+	if len(validRows) > 0 {
+		imported, err := ucase.questionRepository.Import(ctx, validRows, cfg.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, imported...)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Row < results[j].Row
+	})
+
+	return model.NewQuestionImportResult(cfg.DryRun, results), nil
+}
+
 type validateOptions struct {
 	allowNilValues bool
 }
@@ -150,7 +325,7 @@ func validateInput(input *model.QuestionInput, opts validateOptions) error {
 	}
 
 	if input.AnswerB != nil {
-		if *input.AnswerA == "" {
+		if *input.AnswerB == "" {
 			return errors.Errorf(messageAnswerIsRequired, "B")
 		}
 	}
@@ -167,36 +342,6 @@ func validateInput(input *model.QuestionInput, opts validateOptions) error {
 		}
 	}
 
-	if input.Image != nil {
-		if !isValidMIMEType(input.Image.ContentType) {
-			return errors.Errorf(messageImageNotAcceptableMIMEType, "Obrazek pytanie")
-		}
-	}
-
-	if input.AnswerAImage != nil {
-		if !isValidMIMEType(input.AnswerAImage.ContentType) {
-			return errors.Errorf(messageImageNotAcceptableMIMEType, "Obrazek odpowiedź A")
-		}
-	}
-
-	if input.AnswerBImage != nil {
-		if !isValidMIMEType(input.AnswerBImage.ContentType) {
-			return errors.Errorf(messageImageNotAcceptableMIMEType, "Obrazek odpowiedź B")
-		}
-	}
-
-	if input.AnswerCImage != nil {
-		if !isValidMIMEType(input.AnswerCImage.ContentType) {
-			return errors.Errorf(messageImageNotAcceptableMIMEType, "Obrazek odpowiedź C")
-		}
-	}
-
-	if input.AnswerDImage != nil {
-		if !isValidMIMEType(input.AnswerDImage.ContentType) {
-			return errors.Errorf(messageImageNotAcceptableMIMEType, "Obrazek odpowiedź D")
-		}
-	}
-
 	if input.DeleteAnswerAImage != nil && input.AnswerA == nil && input.AnswerAImage == nil {
 		return errors.Errorf(messageCannotDeleteImageWithoutNewAnswer, "Obrazek odpowiedź A")
 	}
@@ -235,6 +380,55 @@ func validateInput(input *model.QuestionInput, opts validateOptions) error {
 	return nil
 }
 
-func isValidMIMEType(contentType string) bool {
-	return imageValidMIMETypes[contentType]
+// validateImages checks the real content of the uploaded images, the content type sent by the client isn't trusted.
+func (ucase *Usecase) validateImages(input *model.QuestionInput) error {
+	for _, image := range [...]struct {
+		upload *graphql.Upload
+		name   string
+	}{
+		{input.Image, "Obrazek pytanie"},
+		{input.AnswerAImage, "Obrazek odpowiedź A"},
+		{input.AnswerBImage, "Obrazek odpowiedź B"},
+		{input.AnswerCImage, "Obrazek odpowiedź C"},
+		{input.AnswerDImage, "Obrazek odpowiedź D"},
+	} {
+		if image.upload == nil {
+			continue
+		}
+		file, err := ucase.imageProcessor.Validate(image.upload.File)
+		switch err {
+		case nil:
+			// the already read bytes of the file are restored by the returned reader
+			image.upload.File = file
+		case imageproc.ErrTooLarge:
+			return errors.Errorf(messageImageTooLarge, image.name)
+		default:
+			return errors.Errorf(messageImageNotAcceptableMIMEType, image.name)
+		}
+	}
+	return nil
+}
+
+// applyExplicitAllocation replaces the qualifications and the limit with the ones resulting from the requested counts.
+func applyExplicitAllocation(cfg *question.GenerateTestConfig) error {
+	if len(cfg.Counts) == 0 {
+		return errors.New(messageCountsAreRequired)
+	}
+	total := 0
+	qualifications := make([]int, 0, len(cfg.Counts))
+	for qualificationID, count := range cfg.Counts {
+		if qualificationID <= 0 || count <= 0 {
+			return errors.New(messageInvalidCount)
+		}
+		total += count
+		qualifications = append(qualifications, qualificationID)
+	}
+	if total > question.TestMaxLimit {
+		return errors.Errorf(messageTooManyQuestions, question.TestMaxLimit)
+	}
+	// the map iteration order is random, the seed should give the same test every time
+	sort.Ints(qualifications)
+	cfg.Qualifications = qualifications
+	cfg.Limit = total
+	return nil
 }
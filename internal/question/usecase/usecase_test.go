@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question/importer"
+)
+
+func TestValidateInputImageOnlyAnswer(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if _, err := zw.Create("a.png"); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// answer A has only an image, so the row has no answerA value at all
+	csv := "content,correctAnswer,answerA,answerAImage,answerB,answerC,answerD,qualificationID\n" +
+		"Pytanie,a,,a.png,b,c,d,1\n"
+	rows, err := importer.Parse(model.QuestionImportFormatCSV, strings.NewReader(csv), archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Err != nil {
+		t.Fatalf("expected 1 valid row, got %v", rows)
+	}
+	input := rows[0].Input
+	if input.AnswerA != nil || input.AnswerAImage == nil {
+		t.Fatal("expected answer A to have only an image")
+	}
+
+	if err := validateInput(input.Sanitize(), validateOptions{false}); err != nil {
+		t.Errorf("expected the input to be valid, got %s", err)
+	}
+
+	blank := " "
+	input.AnswerB = &blank
+	if err := validateInput(input.Sanitize(), validateOptions{false}); err == nil ||
+		err.Error() != fmt.Sprintf(messageAnswerIsRequired, "B") {
+		t.Errorf("expected the blank answer B to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package review
+
+const (
+	FetchMaxLimit  = 500
+	MaxOrders      = 3
+	ReviewMaxLimit = 40
+)
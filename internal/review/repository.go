@@ -0,0 +1,29 @@
+package review
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchConfig struct {
+	Filter *model.ReviewScheduleFilter
+	Offset int
+	Limit  int
+	Sort   []string
+	Count  bool
+}
+
+type GenerateReviewConfig struct {
+	UserID         int
+	Qualifications []int
+	Limit          int
+}
+
+type Repository interface {
+	// Save inserts the given schedules or updates them if they already exist.
+	Save(ctx context.Context, schedules []*model.ReviewSchedule) error
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.ReviewSchedule, int, error)
+	// GenerateReview returns the due questions, the most overdue ones first.
+	GenerateReview(ctx context.Context, cfg *GenerateReviewConfig) ([]*model.Question, error)
+}
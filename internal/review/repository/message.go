@@ -0,0 +1,7 @@
+package repository
+
+const (
+	messageFailedToSaveModel      = "Wystąpił błąd podczas zapisywania harmonogramu powtórek."
+	messageFailedToFetchModel     = "Wystąpił błąd podczas pobierania harmonogramu powtórek."
+	messageFailedToFetchQuestions = "Wystąpił błąd podczas pobierania pytań do powtórki."
+)
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ review.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Save(ctx context.Context, schedules []*model.ReviewSchedule) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	if _, err := repo.
+		Model(&schedules).
+		Context(ctx).
+		OnConflict("(user_id, question_id) DO UPDATE").
+		Set("repetitions = EXCLUDED.repetitions").
+		Set("lapses = EXCLUDED.lapses").
+		Set("interval_days = EXCLUDED.interval_days").
+		Set("ease_factor = EXCLUDED.ease_factor").
+		Set("due_at = EXCLUDED.due_at").
+		Set("last_reviewed_at = EXCLUDED.last_reviewed_at").
+		Insert(); err != nil {
+		return errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Fetch(ctx context.Context, cfg *review.FetchConfig) ([]*model.ReviewSchedule, int, error) {
+	var err error
+	items := make([]*model.ReviewSchedule, 0)
+	total := 0
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Limit(cfg.Limit).
+		Offset(cfg.Offset).
+		Apply(cfg.Filter.Where).
+		Apply(gopgutil.OrderAppender{
+			Orders: cfg.Sort,
+		}.Apply)
+
+	if cfg.Count {
+		total, err = query.SelectAndCount()
+	} else {
+		err = query.Select()
+	}
+	if err != nil && err != pg.ErrNoRows {
+		return nil, 0, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, total, nil
+}
+
+func (repo *PGRepository) GenerateReview(ctx context.Context, cfg *review.GenerateReviewConfig) ([]*model.Question, error) {
+	items := make([]*model.Question, 0)
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Join("INNER JOIN review_schedules AS review_schedule ON review_schedule.question_id = question.id").
+		Where(gopgutil.BuildConditionEquals("review_schedule.user_id"), cfg.UserID).
+		Where(gopgutil.BuildConditionLTE("review_schedule.due_at"), time.Now()).
+		Order("review_schedule.due_at ASC", "question.id ASC").
+		Limit(cfg.Limit)
+	if len(cfg.Qualifications) > 0 {
+		query = query.Where(gopgutil.BuildConditionArray("question.qualification_id"), pg.Array(cfg.Qualifications))
+	}
+	if err := query.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchQuestions)
+	}
+	return items, nil
+}
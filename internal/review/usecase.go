@@ -0,0 +1,14 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Usecase interface {
+	// Record reschedules the questions of the given graded answers.
+	Record(ctx context.Context, userID int, answers []*model.TestAttemptAnswer, reviewedAt time.Time) error
+	GenerateReview(ctx context.Context, cfg *GenerateReviewConfig) ([]*model.Question, error)
+}
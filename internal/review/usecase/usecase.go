@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+)
+
+type Config struct {
+	ReviewRepository review.Repository
+}
+
+type Usecase struct {
+	reviewRepository review.Repository
+}
+
+var _ review.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.ReviewRepository == nil {
+		return nil, errors.New("cfg.ReviewRepository is required")
+	}
+	return &Usecase{
+		cfg.ReviewRepository,
+	}, nil
+}
+
+func (ucase *Usecase) Record(
+	ctx context.Context,
+	userID int,
+	answers []*model.TestAttemptAnswer,
+	reviewedAt time.Time,
+) error {
+	if userID <= 0 {
+		return errors.New(messageInvalidUserID)
+	}
+	if len(answers) == 0 {
+		return nil
+	}
+
+	questionIDs := make([]int, len(answers))
+	for i, answer := range answers {
+		questionIDs[i] = answer.QuestionID
+	}
+	existing, _, err := ucase.reviewRepository.Fetch(ctx, &review.FetchConfig{
+		Count: false,
+		Limit: len(questionIDs),
+		Filter: &model.ReviewScheduleFilter{
+			UserID:     []int{userID},
+			QuestionID: questionIDs,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	scheduleByQuestionID := make(map[int]*model.ReviewSchedule, len(answers))
+	for _, schedule := range existing {
+		scheduleByQuestionID[schedule.QuestionID] = schedule
+	}
+
+	schedules := make([]*model.ReviewSchedule, 0, len(answers))
+	reviewed := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		if reviewed[answer.QuestionID] {
+			continue
+		}
+		reviewed[answer.QuestionID] = true
+
+		schedule, ok := scheduleByQuestionID[answer.QuestionID]
+		if !ok {
+			schedule = model.NewReviewSchedule(userID, answer.QuestionID)
+		}
+		schedule.Review(answer.Correct, reviewedAt)
+		schedules = append(schedules, schedule)
+	}
+
+	return ucase.reviewRepository.Save(ctx, schedules)
+}
+
+func (ucase *Usecase) GenerateReview(ctx context.Context, cfg *review.GenerateReviewConfig) ([]*model.Question, error) {
+	if cfg == nil || cfg.UserID <= 0 {
+		return nil, errors.New(messageInvalidUserID)
+	}
+	if cfg.Limit > review.ReviewMaxLimit || cfg.Limit <= 0 {
+		cfg.Limit = review.ReviewMaxLimit
+	}
+	return ucase.reviewRepository.GenerateReview(ctx, cfg)
+}
@@ -0,0 +1,11 @@
+package session
+
+import "time"
+
+const (
+	FetchMaxLimit          = 100
+	MaxOrders              = 3
+	DefaultLifetime        = 24 * time.Hour
+	StaySignedInLifetime   = 30 * 24 * time.Hour
+	LastSeenUpdateInterval = time.Minute
+)
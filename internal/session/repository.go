@@ -0,0 +1,29 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchConfig struct {
+	Filter        *model.SessionFilter
+	Offset        int
+	Limit         int
+	Sort          []string
+	Count         bool
+	WithRelations bool
+}
+
+type Repository interface {
+	Store(ctx context.Context, input *model.SessionInput) (*model.Session, error)
+	UpdateLastSeenAt(ctx context.Context, id string, lastSeenAt time.Time) error
+	Prolong(ctx context.Context, id string, expiresAt time.Time) error
+	Delete(ctx context.Context, f *model.SessionFilter) ([]*model.Session, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.Session, int, error)
+	StoreRefreshToken(ctx context.Context, input *model.RefreshTokenInput) (*model.RefreshToken, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	// MarkRefreshTokenAsUsed returns false if the token has already been used.
+	MarkRefreshTokenAsUsed(ctx context.Context, id int) (bool, error)
+}
@@ -0,0 +1,10 @@
+package repository
+
+const (
+	messageFailedToSaveModel   = "Wystąpił błąd podczas zapisywania sesji."
+	messageFailedToDeleteModel = "Wystąpił błąd podczas usuwania sesji."
+	messageFailedToFetchModel  = "Wystąpił błąd podczas pobierania sesji."
+
+	messageFailedToSaveRefreshToken  = "Wystąpił błąd podczas zapisywania tokenu odświeżającego."
+	messageFailedToFetchRefreshToken = "Wystąpił błąd podczas pobierania tokenu odświeżającego."
+)
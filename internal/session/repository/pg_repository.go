@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/session"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ session.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Store(ctx context.Context, input *model.SessionInput) (*model.Session, error) {
+	item := input.ToSession()
+	if _, err := repo.
+		Model(item).
+		Context(ctx).
+		Returning("*").
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) UpdateLastSeenAt(ctx context.Context, id string, lastSeenAt time.Time) error {
+	if _, err := repo.
+		Model(&model.Session{}).
+		Context(ctx).
+		Set("last_seen_at = ?", lastSeenAt).
+		Where(gopgutil.BuildConditionEquals("id"), id).
+		Update(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Prolong(ctx context.Context, id string, expiresAt time.Time) error {
+	if _, err := repo.
+		Model(&model.Session{}).
+		Context(ctx).
+		Set("last_seen_at = ?", time.Now()).
+		Set("expires_at = ?", expiresAt).
+		Where(gopgutil.BuildConditionEquals("id"), id).
+		Update(); err != nil && err != pg.ErrNoRows {
+		return errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return nil
+}
+
+func (repo *PGRepository) Delete(ctx context.Context, f *model.SessionFilter) ([]*model.Session, error) {
+	items := make([]*model.Session, 0)
+	if _, err := repo.
+		Model(&items).
+		Context(ctx).
+		Returning("*").
+		Apply(f.Where).
+		Delete(); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToDeleteModel)
+	}
+	return items, nil
+}
+
+func (repo *PGRepository) Fetch(ctx context.Context, cfg *session.FetchConfig) ([]*model.Session, int, error) {
+	var err error
+	items := make([]*model.Session, 0)
+	total := 0
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Limit(cfg.Limit).
+		Offset(cfg.Offset).
+		Apply(cfg.Filter.Where).
+		Apply(gopgutil.OrderAppender{
+			Orders: cfg.Sort,
+		}.Apply)
+	if cfg.WithRelations {
+		query = query.Relation("User")
+	}
+
+	if cfg.Count {
+		total, err = query.SelectAndCount()
+	} else {
+		err = query.Select()
+	}
+	if err != nil && err != pg.ErrNoRows {
+		return nil, 0, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, total, nil
+}
+
+func (repo *PGRepository) StoreRefreshToken(ctx context.Context, input *model.RefreshTokenInput) (*model.RefreshToken, error) {
+	item := input.ToRefreshToken()
+	if _, err := repo.
+		Model(item).
+		Context(ctx).
+		Returning("*").
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveRefreshToken)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	item := &model.RefreshToken{}
+	if err := repo.
+		Model(item).
+		Context(ctx).
+		Where(gopgutil.BuildConditionEquals("token_hash"), tokenHash).
+		Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errorutil.Wrap(err, messageFailedToFetchRefreshToken)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) MarkRefreshTokenAsUsed(ctx context.Context, id int) (bool, error) {
+	res, err := repo.
+		Model(&model.RefreshToken{}).
+		Context(ctx).
+		Set("used_at = ?", time.Now()).
+		Where(gopgutil.BuildConditionEquals("id"), id).
+		Where("used_at IS NULL").
+		Update()
+	if err != nil && err != pg.ErrNoRows {
+		return false, errorutil.Wrap(err, messageFailedToSaveRefreshToken)
+	}
+	return res != nil && res.RowsAffected() > 0, nil
+}
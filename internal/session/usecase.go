@@ -0,0 +1,14 @@
+package session
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Usecase interface {
+	Delete(ctx context.Context, f *model.SessionFilter) ([]*model.Session, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.Session, int, error)
+	FetchActiveByUserID(ctx context.Context, userID int) ([]*model.Session, error)
+	DeleteByUserID(ctx context.Context, userID int, exceptSessionID ...string) ([]*model.Session, error)
+}
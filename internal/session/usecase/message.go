@@ -0,0 +1,5 @@
+package usecase
+
+const (
+	messageInvalidUserID = "Niepoprawne ID użytkownika."
+)
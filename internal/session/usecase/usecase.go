@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/session"
+)
+
+type Config struct {
+	SessionRepository session.Repository
+}
+
+type Usecase struct {
+	sessionRepository session.Repository
+}
+
+var _ session.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.SessionRepository == nil {
+		return nil, errors.New("cfg.SessionRepository is required")
+	}
+	return &Usecase{
+		cfg.SessionRepository,
+	}, nil
+}
+
+func (ucase *Usecase) Delete(ctx context.Context, f *model.SessionFilter) ([]*model.Session, error) {
+	if f == nil {
+		return []*model.Session{}, nil
+	}
+	return ucase.sessionRepository.Delete(ctx, f)
+}
+
+func (ucase *Usecase) Fetch(ctx context.Context, cfg *session.FetchConfig) ([]*model.Session, int, error) {
+	if cfg == nil {
+		cfg = &session.FetchConfig{
+			Limit: session.FetchMaxLimit,
+			Count: true,
+		}
+	}
+	if cfg.Limit > session.FetchMaxLimit || cfg.Limit <= 0 {
+		cfg.Limit = session.FetchMaxLimit
+	}
+	if len(cfg.Sort) > session.MaxOrders {
+		cfg.Sort = cfg.Sort[0:session.MaxOrders]
+	}
+	return ucase.sessionRepository.Fetch(ctx, cfg)
+}
+
+func (ucase *Usecase) FetchActiveByUserID(ctx context.Context, userID int) ([]*model.Session, error) {
+	if userID <= 0 {
+		return nil, errors.New(messageInvalidUserID)
+	}
+	items, _, err := ucase.Fetch(ctx, &session.FetchConfig{
+		Count: false,
+		Sort:  []string{"last_seen_at DESC"},
+		Filter: &model.SessionFilter{
+			UserID:      []int{userID},
+			ExpiresAtGT: time.Now(),
+		},
+	})
+	return items, err
+}
+
+func (ucase *Usecase) DeleteByUserID(ctx context.Context, userID int, exceptSessionID ...string) ([]*model.Session, error) {
+	if userID <= 0 {
+		return nil, errors.New(messageInvalidUserID)
+	}
+	return ucase.Delete(ctx, &model.SessionFilter{
+		UserID: []int{userID},
+		IDNEQ:  exceptSessionID,
+	})
+}
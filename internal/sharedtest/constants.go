@@ -0,0 +1,9 @@
+package sharedtest
+
+const (
+	FetchMaxLimit = 100
+	MaxOrders     = 3
+	CodeLength    = 8
+	// CodeAlphabet omits the characters that are easy to confuse with each other (0/O, 1/I/L).
+	CodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
@@ -0,0 +1,20 @@
+package sharedtest
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchConfig struct {
+	Filter *model.SharedTestFilter
+	Offset int
+	Limit  int
+	Sort   []string
+	Count  bool
+}
+
+type Repository interface {
+	Store(ctx context.Context, t *model.SharedTest) (*model.SharedTest, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.SharedTest, int, error)
+}
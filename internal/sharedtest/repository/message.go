@@ -0,0 +1,7 @@
+package repository
+
+const (
+	messageFailedToSaveModel  = "Wystąpił błąd podczas udostępniania testu."
+	messageFailedToFetchModel = "Wystąpił błąd podczas pobierania udostępnionych testów."
+	messageCodeAlreadyTaken   = "Wygenerowany kod jest już zajęty, spróbuj ponownie."
+)
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"strings"
+
+	"github.com/go-pg/pg/v10"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ sharedtest.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Store(ctx context.Context, t *model.SharedTest) (*model.SharedTest, error) {
+	if _, err := repo.
+		Model(t).
+		Context(ctx).
+		Returning("*").
+		Insert(); err != nil {
+		if strings.Contains(err.Error(), "shared_tests_pkey") {
+			return nil, errorutil.Wrap(err, messageCodeAlreadyTaken)
+		}
+		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return t, nil
+}
+
+func (repo *PGRepository) Fetch(ctx context.Context, cfg *sharedtest.FetchConfig) ([]*model.SharedTest, int, error) {
+	var err error
+	items := make([]*model.SharedTest, 0)
+	total := 0
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Limit(cfg.Limit).
+		Offset(cfg.Offset).
+		Apply(cfg.Filter.Where).
+		Apply(gopgutil.OrderAppender{
+			Orders: cfg.Sort,
+		}.Apply)
+
+	if cfg.Count {
+		total, err = query.SelectAndCount()
+	} else {
+		err = query.Select()
+	}
+	if err != nil && err != pg.ErrNoRows {
+		return nil, 0, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, total, nil
+}
@@ -0,0 +1,14 @@
+package sharedtest
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Usecase interface {
+	// Store saves the test configuration under a newly generated code.
+	Store(ctx context.Context, input *model.SharedTestInput) (*model.SharedTest, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.SharedTest, int, error)
+	GetByCode(ctx context.Context, code string) (*model.SharedTest, error)
+}
@@ -0,0 +1,10 @@
+package usecase
+
+const (
+	messageInvalidUserID            = "Niepoprawne ID użytkownika."
+	messageItemNotFound             = "Nie znaleziono testu o podanym kodzie."
+	messageQualificationsAreMissing = "Wybierz przynajmniej jedną kwalifikację."
+	messageExamRequiresOneQual      = "Egzamin można udostępnić tylko dla jednej kwalifikacji."
+	messageInvalidLimit             = "Liczba pytań musi być z przedziału od 1 do %d."
+	messageInvalidSeed              = "Ziarno musi być liczbą z przedziału od 1 do %d."
+)
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"github.com/pkg/errors"
+	"math/big"
+	"strings"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/sharedtest"
+)
+
+type Config struct {
+	SharedTestRepository sharedtest.Repository
+}
+
+type Usecase struct {
+	sharedTestRepository sharedtest.Repository
+}
+
+var _ sharedtest.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.SharedTestRepository == nil {
+		return nil, errors.New("cfg.SharedTestRepository is required")
+	}
+	return &Usecase{
+		cfg.SharedTestRepository,
+	}, nil
+}
+
+func (ucase *Usecase) Store(ctx context.Context, input *model.SharedTestInput) (*model.SharedTest, error) {
+	if input == nil || input.CreatedByID <= 0 {
+		return nil, errors.New(messageInvalidUserID)
+	}
+	t := input.ToSharedTest()
+	if err := validateSharedTest(t); err != nil {
+		return nil, err
+	}
+
+	if t.Seed == 0 {
+		seed, err := question.GenerateSeed()
+		if err != nil {
+			return nil, err
+		}
+		t.Seed = seed
+	}
+	code, err := generateCode()
+	if err != nil {
+		return nil, err
+	}
+	t.Code = code
+
+	return ucase.sharedTestRepository.Store(ctx, t)
+}
+
+func (ucase *Usecase) Fetch(ctx context.Context, cfg *sharedtest.FetchConfig) ([]*model.SharedTest, int, error) {
+	if cfg == nil {
+		cfg = &sharedtest.FetchConfig{
+			Limit: sharedtest.FetchMaxLimit,
+			Count: true,
+		}
+	}
+	if cfg.Limit > sharedtest.FetchMaxLimit || cfg.Limit <= 0 {
+		cfg.Limit = sharedtest.FetchMaxLimit
+	}
+	if len(cfg.Sort) > sharedtest.MaxOrders {
+		cfg.Sort = cfg.Sort[0:sharedtest.MaxOrders]
+	}
+	return ucase.sharedTestRepository.Fetch(ctx, cfg)
+}
+
+func (ucase *Usecase) GetByCode(ctx context.Context, code string) (*model.SharedTest, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != sharedtest.CodeLength {
+		return nil, errors.New(messageItemNotFound)
+	}
+	items, _, err := ucase.Fetch(ctx, &sharedtest.FetchConfig{
+		Limit: 1,
+		Count: false,
+		Filter: &model.SharedTestFilter{
+			Code: []string{code},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New(messageItemNotFound)
+	}
+	return items[0], nil
+}
+
+func validateSharedTest(t *model.SharedTest) error {
+	if len(t.QualificationIDs) == 0 {
+		return errors.New(messageQualificationsAreMissing)
+	}
+
+	if t.Exam {
+		if len(t.QualificationIDs) != 1 {
+			return errors.New(messageExamRequiresOneQual)
+		}
+		// the number of questions is taken from the qualification when the exam is started
+		t.Limit = 0
+	} else {
+		if t.Limit == 0 {
+			t.Limit = question.TestMaxLimit
+		}
+		if t.Limit < 0 || t.Limit > question.TestMaxLimit {
+			return errors.Errorf(messageInvalidLimit, question.TestMaxLimit)
+		}
+	}
+
+	if t.Seed < 0 || t.Seed > question.MaxSeed {
+		return errors.Errorf(messageInvalidSeed, question.MaxSeed)
+	}
+
+	return nil
+}
+
+func generateCode() (string, error) {
+	max := big.NewInt(int64(len(sharedtest.CodeAlphabet)))
+	code := make([]byte, sharedtest.CodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.Wrap(err, "couldn't generate a code")
+		}
+		code[i] = sharedtest.CodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,11 @@
+package statistics
+
+const (
+	DefaultDays = 30
+	MaxDays     = 365
+	// WeakestQualificationsLimit is the max number of qualifications returned as the weakest ones.
+	WeakestQualificationsLimit = 5
+	// WeakestQualificationMinQuestions is the min number of questions a user must have seen in a qualification
+	// before it can be considered one of their weakest ones.
+	WeakestQualificationMinQuestions = 10
+)
@@ -0,0 +1,26 @@
+package statistics
+
+import (
+	"context"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchByQualificationConfig struct {
+	UserID int
+	// MinQuestions skips the qualifications with fewer questions than the given number.
+	MinQuestions int
+	// OrderByAccuracy orders the qualifications by accuracy (ascending) instead of by ID.
+	OrderByAccuracy bool
+	Limit           int
+}
+
+type Repository interface {
+	// GetSummary returns the statistics without breakdowns (only the number of attempts and the totals are set).
+	GetSummary(ctx context.Context, userID int) (*model.UserStatistics, error)
+	GetStreak(ctx context.Context, userID int) (*model.StreakStatistics, error)
+	FetchByQualification(ctx context.Context, cfg *FetchByQualificationConfig) ([]*model.QualificationStatistics, error)
+	FetchBySource(ctx context.Context, userID int) ([]*model.SourceStatistics, error)
+	FetchDaily(ctx context.Context, userID int, since time.Time) ([]*model.DailyStatistics, error)
+}
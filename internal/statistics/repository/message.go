@@ -0,0 +1,5 @@
+package repository
+
+const (
+	messageFailedToFetchStatistics = "Wystąpił błąd podczas pobierania statystyk."
+)
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/statistics"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ statistics.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) GetSummary(ctx context.Context, userID int) (*model.UserStatistics, error) {
+	item := &model.UserStatistics{
+		UserID: userID,
+	}
+	if err := repo.
+		answersOfUser(ctx, userID).
+		ColumnExpr("count(DISTINCT test_attempt_answer.test_attempt_id) AS attempts").
+		Select(item); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchStatistics)
+	}
+	return item, nil
+}
+
+// streakQuery splits the days the user has submitted at least one test on into groups of consecutive days
+// (subtracting the row number from a day gives the same date for all days in a row).
+const streakQuery = `
+WITH days AS (
+	SELECT DISTINCT submitted_at::date AS day
+	FROM test_attempts
+	WHERE user_id = ? AND submitted_at IS NOT NULL
+), streaks AS (
+	SELECT max(day) AS last_day, count(*) AS length
+	FROM (SELECT day, day - (row_number() OVER (ORDER BY day))::int AS grp FROM days) AS grouped_days
+	GROUP BY grp
+)
+SELECT
+	coalesce(max(length) FILTER (WHERE last_day >= current_date - 1), 0) AS current,
+	coalesce(max(length), 0) AS longest
+FROM streaks
+`
+
+func (repo *PGRepository) GetStreak(ctx context.Context, userID int) (*model.StreakStatistics, error) {
+	item := &model.StreakStatistics{}
+	if _, err := repo.QueryOneContext(ctx, item, streakQuery, userID); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchStatistics)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) FetchByQualification(
+	ctx context.Context,
+	cfg *statistics.FetchByQualificationConfig,
+) ([]*model.QualificationStatistics, error) {
+	items := make([]*model.QualificationStatistics, 0)
+	query := repo.
+		answersOfUser(ctx, cfg.UserID).
+		Join("INNER JOIN questions AS question ON question.id = test_attempt_answer.question_id").
+		ColumnExpr("question.qualification_id").
+		Group("question.qualification_id")
+	if cfg.MinQuestions > 0 {
+		query = query.Having("count(*) >= ?", cfg.MinQuestions)
+	}
+	if cfg.OrderByAccuracy {
+		query = query.Order("accuracy ASC", "question.qualification_id ASC")
+	} else {
+		query = query.Order("question.qualification_id ASC")
+	}
+	if cfg.Limit > 0 {
+		query = query.Limit(cfg.Limit)
+	}
+	if err := query.Select(&items); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchStatistics)
+	}
+	return items, nil
+}
+
+func (repo *PGRepository) FetchBySource(ctx context.Context, userID int) ([]*model.SourceStatistics, error) {
+	items := make([]*model.SourceStatistics, 0)
+	if err := repo.
+		answersOfUser(ctx, userID).
+		Join("INNER JOIN questions AS question ON question.id = test_attempt_answer.question_id").
+		ColumnExpr(`coalesce(question."from", '') AS "from"`).
+		Group(`question.from`).
+		Order(`question.from ASC`).
+		Select(&items); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchStatistics)
+	}
+	return items, nil
+}
+
+func (repo *PGRepository) FetchDaily(ctx context.Context, userID int, since time.Time) ([]*model.DailyStatistics, error) {
+	items := make([]*model.DailyStatistics, 0)
+	if err := repo.
+		answersOfUser(ctx, userID).
+		ColumnExpr("date_trunc('day', test_attempt.submitted_at) AS date").
+		Where("test_attempt.submitted_at >= ?", since).
+		GroupExpr("date_trunc('day', test_attempt.submitted_at)").
+		OrderExpr("date ASC").
+		Select(&items); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToFetchStatistics)
+	}
+	return items, nil
+}
+
+// answersOfUser returns a query selecting the aggregated answers (model.AnswerStatistics)
+// given by the user in their submitted test attempts.
+func (repo *PGRepository) answersOfUser(ctx context.Context, userID int) *orm.Query {
+	return repo.
+		Model((*model.TestAttemptAnswer)(nil)).
+		Context(ctx).
+		Join("INNER JOIN test_attempts AS test_attempt ON test_attempt.id = test_attempt_answer.test_attempt_id").
+		Where("test_attempt.user_id = ?", userID).
+		Where("test_attempt.submitted_at IS NOT NULL").
+		ColumnExpr("count(*) AS questions").
+		ColumnExpr("count(test_attempt_answer.answer) AS answered").
+		ColumnExpr("count(*) FILTER (WHERE test_attempt_answer.correct) AS correct").
+		ColumnExpr("coalesce(avg(test_attempt_answer.correct::int), 0)::float AS accuracy")
+}
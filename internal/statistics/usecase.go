@@ -0,0 +1,17 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type GetConfig struct {
+	UserID int
+	// Days is the number of days (including today) the daily statistics are returned for.
+	Days int
+}
+
+type Usecase interface {
+	Get(ctx context.Context, cfg *GetConfig) (*model.UserStatistics, error)
+}
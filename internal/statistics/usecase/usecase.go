@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/statistics"
+)
+
+type Config struct {
+	StatisticsRepository statistics.Repository
+}
+
+type Usecase struct {
+	statisticsRepository statistics.Repository
+}
+
+var _ statistics.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.StatisticsRepository == nil {
+		return nil, errors.New("cfg.StatisticsRepository is required")
+	}
+	return &Usecase{
+		cfg.StatisticsRepository,
+	}, nil
+}
+
+func (ucase *Usecase) Get(ctx context.Context, cfg *statistics.GetConfig) (*model.UserStatistics, error) {
+	if cfg == nil || cfg.UserID <= 0 {
+		return nil, errors.New(messageInvalidUserID)
+	}
+	if cfg.Days <= 0 {
+		cfg.Days = statistics.DefaultDays
+	}
+	if cfg.Days > statistics.MaxDays {
+		cfg.Days = statistics.MaxDays
+	}
+
+	stats, err := ucase.statisticsRepository.GetSummary(ctx, cfg.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.Streak, err = ucase.statisticsRepository.GetStreak(ctx, cfg.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.ByQualification, err = ucase.statisticsRepository.FetchByQualification(ctx, &statistics.FetchByQualificationConfig{
+		UserID: cfg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.WeakestQualifications, err = ucase.statisticsRepository.FetchByQualification(
+		ctx,
+		&statistics.FetchByQualificationConfig{
+			UserID:          cfg.UserID,
+			MinQuestions:    statistics.WeakestQualificationMinQuestions,
+			OrderByAccuracy: true,
+			Limit:           statistics.WeakestQualificationsLimit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.BySource, err = ucase.statisticsRepository.FetchBySource(ctx, cfg.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -(cfg.Days - 1))
+	stats.OverTime, err = ucase.statisticsRepository.FetchDaily(ctx, cfg.UserID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
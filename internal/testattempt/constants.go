@@ -0,0 +1,11 @@
+package testattempt
+
+import "time"
+
+const (
+	FetchDefaultLimit = 20
+	FetchMaxLimit     = 100
+	MaxOrders         = 3
+	// DeadlineGracePeriod compensates for the network latency, an exam submitted within this period after the deadline is accepted.
+	DeadlineGracePeriod = 30 * time.Second
+)
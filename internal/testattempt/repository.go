@@ -0,0 +1,25 @@
+package testattempt
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type FetchConfig struct {
+	Filter *model.TestAttemptFilter
+	Offset int
+	Limit  int
+	Sort   []string
+	Count  bool
+	// WithAnswers loads the submitted answers.
+	WithAnswers bool
+}
+
+type Repository interface {
+	Store(ctx context.Context, input *model.TestAttemptInput) (*model.TestAttempt, error)
+	// Submit stores the answers and the score of the given attempt.
+	// It returns false if the attempt has already been submitted.
+	Submit(ctx context.Context, id int, answers []*model.TestAttemptAnswer, score int) (bool, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.TestAttempt, int, error)
+}
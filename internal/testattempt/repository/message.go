@@ -0,0 +1,6 @@
+package repository
+
+const (
+	messageFailedToSaveModel  = "Wystąpił błąd podczas zapisywania podejścia do testu."
+	messageFailedToFetchModel = "Wystąpił błąd podczas pobierania podejść do testu."
+)
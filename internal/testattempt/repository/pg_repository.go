@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ testattempt.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Store(ctx context.Context, input *model.TestAttemptInput) (*model.TestAttempt, error) {
+	item := input.ToTestAttempt()
+	if _, err := repo.
+		Model(item).
+		Context(ctx).
+		Returning("*").
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) Submit(ctx context.Context, id int, answers []*model.TestAttemptAnswer, score int) (bool, error) {
+	submitted := false
+	err := repo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		res, err := tx.
+			Model(&model.TestAttempt{}).
+			Context(ctx).
+			Set("submitted_at = ?", time.Now()).
+			Set("score = ?", score).
+			Where(gopgutil.BuildConditionEquals("id"), id).
+			Where("submitted_at IS NULL").
+			Update()
+		if err != nil && err != pg.ErrNoRows {
+			return err
+		}
+		if res == nil || res.RowsAffected() == 0 {
+			return nil
+		}
+
+		if len(answers) > 0 {
+			for _, answer := range answers {
+				answer.TestAttemptID = id
+			}
+			if _, err := tx.
+				Model(&answers).
+				Context(ctx).
+				Insert(); err != nil {
+				return err
+			}
+		}
+
+		submitted = true
+		return nil
+	})
+	if err != nil {
+		return false, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return submitted, nil
+}
+
+func (repo *PGRepository) Fetch(ctx context.Context, cfg *testattempt.FetchConfig) ([]*model.TestAttempt, int, error) {
+	var err error
+	items := make([]*model.TestAttempt, 0)
+	total := 0
+	query := repo.
+		Model(&items).
+		Context(ctx).
+		Limit(cfg.Limit).
+		Offset(cfg.Offset).
+		Apply(cfg.Filter.Where).
+		Apply(gopgutil.OrderAppender{
+			Orders: cfg.Sort,
+		}.Apply)
+	if cfg.WithAnswers {
+		query = query.Relation("Answers", func(q *orm.Query) (*orm.Query, error) {
+			return q.Order("id ASC"), nil
+		})
+	}
+
+	if cfg.Count {
+		total, err = query.SelectAndCount()
+	} else {
+		err = query.Select()
+	}
+	if err != nil && err != pg.ErrNoRows {
+		return nil, 0, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return items, total, nil
+}
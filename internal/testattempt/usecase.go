@@ -0,0 +1,32 @@
+package testattempt
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type StartConfig struct {
+	UserID         int
+	Qualifications []int
+	Limit          int
+	Seed           int
+	// ShuffleAnswers displays the answers of every question in a random order, see model.TestAttempt.AnswerPermutation.
+	ShuffleAnswers bool
+}
+
+type StartExamConfig struct {
+	UserID          int
+	QualificationID int
+	Seed            int
+	ShuffleAnswers  bool
+}
+
+type Usecase interface {
+	Start(ctx context.Context, cfg *StartConfig) (*model.TestAttempt, error)
+	// StartExam starts a test with the number of questions and the time limit of the real exam in the given qualification.
+	StartExam(ctx context.Context, cfg *StartExamConfig) (*model.TestAttempt, error)
+	Submit(ctx context.Context, userID, id int, answers []*model.TestAttemptAnswerInput) (*model.TestAttempt, error)
+	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.TestAttempt, int, error)
+	GetByID(ctx context.Context, id int) (*model.TestAttempt, error)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+const (
+	messageInvalidID                = "Niepoprawne ID."
+	messageItemNotFound             = "Nie znaleziono podejścia do testu."
+	messageQualificationsAreMissing = "Wybierz przynajmniej jedną kwalifikację."
+	messageNoQuestions              = "Brak pytań dla wybranych kwalifikacji."
+	messageAlreadySubmitted         = "Ten test został już rozwiązany."
+	messageDeadlineExceeded         = "Czas na rozwiązanie egzaminu minął."
+	messageQuestionNotInTest        = "Pytanie o ID %d nie jest częścią tego testu."
+	messageDuplicatedAnswer         = "Udzielono więcej niż jednej odpowiedzi na pytanie o ID %d."
+	messageInvalidAnswer            = "Odpowiedź na pytanie o ID %d jest nieprawidłowa."
+)
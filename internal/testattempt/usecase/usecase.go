@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"time"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/qualification"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/question"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/review"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/testattempt"
+)
+
+var log = logrus.WithField("package", "internal/testattempt/usecase")
+
+type Config struct {
+	TestAttemptRepository testattempt.Repository
+	QuestionUsecase       question.Usecase
+	QualificationUsecase  qualification.Usecase
+	ReviewUsecase         review.Usecase
+}
+
+type Usecase struct {
+	testAttemptRepository testattempt.Repository
+	questionUsecase       question.Usecase
+	qualificationUsecase  qualification.Usecase
+	reviewUsecase         review.Usecase
+}
+
+var _ testattempt.Usecase = &Usecase{}
+
+func New(cfg *Config) (*Usecase, error) {
+	if cfg == nil || cfg.TestAttemptRepository == nil {
+		return nil, errors.New("cfg.TestAttemptRepository is required")
+	}
+	if cfg.QuestionUsecase == nil {
+		return nil, errors.New("cfg.QuestionUsecase is required")
+	}
+	if cfg.QualificationUsecase == nil {
+		return nil, errors.New("cfg.QualificationUsecase is required")
+	}
+	if cfg.ReviewUsecase == nil {
+		return nil, errors.New("cfg.ReviewUsecase is required")
+	}
+	return &Usecase{
+		cfg.TestAttemptRepository,
+		cfg.QuestionUsecase,
+		cfg.QualificationUsecase,
+		cfg.ReviewUsecase,
+	}, nil
+}
+
+func (ucase *Usecase) Start(ctx context.Context, cfg *testattempt.StartConfig) (*model.TestAttempt, error) {
+	if cfg == nil || cfg.UserID <= 0 {
+		return nil, errors.New(messageInvalidID)
+	}
+	if len(cfg.Qualifications) == 0 {
+		return nil, errors.New(messageQualificationsAreMissing)
+	}
+
+	return ucase.start(ctx, &question.GenerateTestConfig{
+		Qualifications: cfg.Qualifications,
+		Limit:          cfg.Limit,
+		Seed:           cfg.Seed,
+	}, &model.TestAttemptInput{
+		UserID:           cfg.UserID,
+		QualificationIDs: cfg.Qualifications,
+		ShuffleAnswers:   cfg.ShuffleAnswers,
+	})
+}
+
+func (ucase *Usecase) StartExam(ctx context.Context, cfg *testattempt.StartExamConfig) (*model.TestAttempt, error) {
+	if cfg == nil || cfg.UserID <= 0 {
+		return nil, errors.New(messageInvalidID)
+	}
+	if cfg.QualificationID <= 0 {
+		return nil, errors.New(messageQualificationsAreMissing)
+	}
+
+	q, err := ucase.qualificationUsecase.GetByID(ctx, cfg.QualificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(time.Duration(q.ExamDurationMinutes) * time.Minute)
+	return ucase.start(ctx, &question.GenerateTestConfig{
+		Qualifications: []int{q.ID},
+		Limit:          q.ExamQuestionCount,
+		Seed:           cfg.Seed,
+		Exam:           true,
+	}, &model.TestAttemptInput{
+		UserID:           cfg.UserID,
+		QualificationIDs: []int{q.ID},
+		Exam:             true,
+		Deadline:         &deadline,
+		ShuffleAnswers:   cfg.ShuffleAnswers,
+	})
+}
+
+func (ucase *Usecase) start(
+	ctx context.Context,
+	generateCfg *question.GenerateTestConfig,
+	input *model.TestAttemptInput,
+) (*model.TestAttempt, error) {
+	questions, err := ucase.questionUsecase.GenerateTest(ctx, generateCfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, errors.New(messageNoQuestions)
+	}
+
+	input.Seed = generateCfg.Seed
+	input.QuestionIDs = make([]int, len(questions))
+	for i, q := range questions {
+		input.QuestionIDs[i] = q.ID
+	}
+	attempt, err := ucase.testAttemptRepository.Store(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	attempt.Questions = questions
+
+	return attempt, nil
+}
+
+// Submit grades the given answers against the correct ones and stores them.
+// Questions without an answer are stored as well and are treated as answered incorrectly.
+func (ucase *Usecase) Submit(
+	ctx context.Context,
+	userID, id int,
+	answers []*model.TestAttemptAnswerInput,
+) (*model.TestAttempt, error) {
+	attempt, err := ucase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.UserID != userID {
+		return nil, errors.New(messageItemNotFound)
+	}
+	if attempt.IsSubmitted() {
+		return nil, errors.New(messageAlreadySubmitted)
+	}
+	if attempt.IsOverdue(time.Now().Add(-testattempt.DeadlineGracePeriod)) {
+		return nil, errors.New(messageDeadlineExceeded)
+	}
+
+	answerByQuestionID := make(map[int]*model.Answer, len(answers))
+	for _, answer := range answers {
+		if answer == nil {
+			continue
+		}
+		if !attempt.HasQuestion(answer.QuestionID) {
+			return nil, errors.Errorf(messageQuestionNotInTest, answer.QuestionID)
+		}
+		if _, ok := answerByQuestionID[answer.QuestionID]; ok {
+			return nil, errors.Errorf(messageDuplicatedAnswer, answer.QuestionID)
+		}
+		if answer.Answer == nil {
+			answerByQuestionID[answer.QuestionID] = nil
+			continue
+		}
+		if !answer.Answer.IsValid() {
+			return nil, errors.Errorf(messageInvalidAnswer, answer.QuestionID)
+		}
+		// the user answers with the options they've seen, the answer is graded against the stored key
+		stored := attempt.AnswerPermutation(answer.QuestionID).ToStored(*answer.Answer)
+		answerByQuestionID[answer.QuestionID] = &stored
+	}
+
+	questions, _, err := ucase.questionUsecase.Fetch(ctx, &question.FetchConfig{
+		Count: false,
+		Limit: len(attempt.QuestionIDs),
+		Filter: &model.QuestionFilter{
+			ID: attempt.QuestionIDs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	score := 0
+	gradedAnswers := make([]*model.TestAttemptAnswer, 0, len(questions))
+	for _, q := range questions {
+		answer := answerByQuestionID[q.ID]
+		correct := answer != nil && *answer == q.CorrectAnswer
+		if correct {
+			score++
+		}
+		gradedAnswers = append(gradedAnswers, &model.TestAttemptAnswer{
+			QuestionID: q.ID,
+			Answer:     answer,
+			Correct:    correct,
+		})
+	}
+
+	submitted, err := ucase.testAttemptRepository.Submit(ctx, attempt.ID, gradedAnswers, score)
+	if err != nil {
+		return nil, err
+	}
+	if !submitted {
+		return nil, errors.New(messageAlreadySubmitted)
+	}
+
+	// the attempt has already been stored, so a failure here shouldn't be reported to the user
+	if err := ucase.reviewUsecase.Record(ctx, attempt.UserID, gradedAnswers, time.Now()); err != nil {
+		log.WithError(err).WithField("testAttemptID", attempt.ID).Warn("Couldn't update the review schedules")
+	}
+
+	return ucase.GetByID(ctx, attempt.ID)
+}
+
+func (ucase *Usecase) Fetch(ctx context.Context, cfg *testattempt.FetchConfig) ([]*model.TestAttempt, int, error) {
+	if cfg == nil {
+		cfg = &testattempt.FetchConfig{
+			Limit: testattempt.FetchDefaultLimit,
+			Count: true,
+		}
+	}
+	if cfg.Limit > testattempt.FetchMaxLimit || cfg.Limit <= 0 {
+		cfg.Limit = testattempt.FetchMaxLimit
+	}
+	if len(cfg.Sort) > testattempt.MaxOrders {
+		cfg.Sort = cfg.Sort[0:testattempt.MaxOrders]
+	}
+	return ucase.testAttemptRepository.Fetch(ctx, cfg)
+}
+
+func (ucase *Usecase) GetByID(ctx context.Context, id int) (*model.TestAttempt, error) {
+	if id <= 0 {
+		return nil, errors.New(messageInvalidID)
+	}
+	items, _, err := ucase.Fetch(ctx, &testattempt.FetchConfig{
+		Limit:       1,
+		Count:       false,
+		WithAnswers: true,
+		Filter: &model.TestAttemptFilter{
+			ID: []int{id},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New(messageItemNotFound)
+	}
+	return items[0], nil
+}
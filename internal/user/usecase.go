@@ -9,9 +9,14 @@ import (
 type Usecase interface {
 	Store(ctx context.Context, input *model.UserInput) (*model.User, error)
 	UpdateOneByID(ctx context.Context, id int, input *model.UserInput) (*model.User, error)
+	UpdateMe(ctx context.Context, id int, input *model.UserInput) (*model.User, error)
+	ChangePassword(ctx context.Context, id int, currentPassword, newPassword string) (*model.User, error)
 	UpdateMany(ctx context.Context, f *model.UserFilter, input *model.UserInput) ([]*model.User, error)
 	Delete(ctx context.Context, f *model.UserFilter) ([]*model.User, error)
 	Fetch(ctx context.Context, cfg *FetchConfig) ([]*model.User, int, error)
 	GetByID(ctx context.Context, id int) (*model.User, error)
 	GetByCredentials(ctx context.Context, email, password string) (*model.User, error)
+	// ValidateInput checks the fields that are set the same way UpdateMany does,
+	// so that the input can be saved in another way (e.g. along with a token in a single transaction).
+	ValidateInput(input *model.UserInput) error
 }
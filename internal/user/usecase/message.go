@@ -12,4 +12,6 @@ const (
 	messagePasswordIsRequired    = "Wymagane jest wprowadzenie hasła."
 	messagePasswordInvalidLength = "Długość hasła powinna wynosić %d-%d znaków."
 	messageInvalidRole           = "Nieznana rola użytkownika."
+	messageForbiddenFields       = "Nie możesz samodzielnie zmienić roli, statusu aktywacji, adresu e-mail ani hasła."
+	messageInvalidPassword       = "Wprowadzone aktualne hasło jest nieprawidłowe."
 )
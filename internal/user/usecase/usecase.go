@@ -55,6 +55,33 @@ func (ucase *Usecase) UpdateOneByID(ctx context.Context, id int, input *model.Us
 	return items[0], nil
 }
 
+// UpdateMe updates the profile of the user with the given ID. Only the display name can be changed this way.
+func (ucase *Usecase) UpdateMe(ctx context.Context, id int, input *model.UserInput) (*model.User, error) {
+	if input == nil {
+		return nil, errors.New(messageEmptyPayload)
+	}
+	if input.Role != nil || input.Activated != nil || input.Email != nil || input.Password != nil {
+		return nil, errors.New(messageForbiddenFields)
+	}
+	if input.DisplayName == nil {
+		return nil, errors.New(messageEmptyPayload)
+	}
+	return ucase.UpdateOneByID(ctx, id, input)
+}
+
+func (ucase *Usecase) ChangePassword(ctx context.Context, id int, currentPassword, newPassword string) (*model.User, error) {
+	u, err := ucase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.CompareHashAndPassword(currentPassword); err != nil {
+		return nil, errors.New(messageInvalidPassword)
+	}
+	return ucase.UpdateOneByID(ctx, id, &model.UserInput{
+		Password: &newPassword,
+	})
+}
+
 func (ucase *Usecase) UpdateMany(ctx context.Context, f *model.UserFilter, input *model.UserInput) ([]*model.User, error) {
 	if f == nil {
 		return []*model.User{}, nil
@@ -126,6 +153,10 @@ func (ucase *Usecase) GetByCredentials(ctx context.Context, email, password stri
 	return items[0], nil
 }
 
+func (ucase *Usecase) ValidateInput(input *model.UserInput) error {
+	return validateInput(input.Sanitize(), validateOptions{true})
+}
+
 type validateOptions struct {
 	acceptNilValues bool
 }
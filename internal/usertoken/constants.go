@@ -0,0 +1,8 @@
+package usertoken
+
+import "time"
+
+const (
+	ActivationTokenLifetime    = 72 * time.Hour
+	PasswordResetTokenLifetime = time.Hour
+)
@@ -0,0 +1,18 @@
+package usertoken
+
+import (
+	"context"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+)
+
+type Repository interface {
+	Store(ctx context.Context, input *model.UserTokenInput) (*model.UserToken, error)
+	GetByHash(ctx context.Context, t model.UserTokenType, tokenHash string) (*model.UserToken, error)
+	// MarkAsUsed returns false if the token has already been used.
+	MarkAsUsed(ctx context.Context, id int) (bool, error)
+	// Redeem marks the token as used and applies the input to its owner in a single transaction,
+	// it returns false (and doesn't change the user) if the token has already been used.
+	Redeem(ctx context.Context, id int, input *model.UserInput) (bool, error)
+	Delete(ctx context.Context, f *model.UserTokenFilter) ([]*model.UserToken, error)
+}
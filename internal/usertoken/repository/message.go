@@ -0,0 +1,7 @@
+package repository
+
+const (
+	messageFailedToSaveModel   = "Wystąpił błąd podczas zapisywania tokenu."
+	messageFailedToDeleteModel = "Wystąpił błąd podczas usuwania tokenu."
+	messageFailedToFetchModel  = "Wystąpił błąd podczas pobierania tokenu."
+)
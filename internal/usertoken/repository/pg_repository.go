@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kichiyaki/gopgutil/v10"
+	"github.com/pkg/errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+
+	"github.com/zdam-egzamin-zawodowy/backend/internal/model"
+	"github.com/zdam-egzamin-zawodowy/backend/internal/usertoken"
+	"github.com/zdam-egzamin-zawodowy/backend/util/errorutil"
+)
+
+type PGRepositoryConfig struct {
+	DB *pg.DB
+}
+
+type PGRepository struct {
+	*pg.DB
+}
+
+var _ usertoken.Repository = &PGRepository{}
+
+func NewPGRepository(cfg *PGRepositoryConfig) (*PGRepository, error) {
+	if cfg == nil || cfg.DB == nil {
+		return nil, errors.New("cfg.DB is required")
+	}
+	return &PGRepository{
+		cfg.DB,
+	}, nil
+}
+
+func (repo *PGRepository) Store(ctx context.Context, input *model.UserTokenInput) (*model.UserToken, error) {
+	item := input.ToUserToken()
+	if _, err := repo.
+		Model(item).
+		Context(ctx).
+		Returning("*").
+		Insert(); err != nil {
+		return nil, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) GetByHash(ctx context.Context, t model.UserTokenType, tokenHash string) (*model.UserToken, error) {
+	item := &model.UserToken{}
+	if err := repo.
+		Model(item).
+		Context(ctx).
+		Relation("User").
+		Where(gopgutil.BuildConditionEquals("?"), gopgutil.AddAliasToColumnName("type", "user_token"), t).
+		Where(gopgutil.BuildConditionEquals("?"), gopgutil.AddAliasToColumnName("token_hash", "user_token"), tokenHash).
+		Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errorutil.Wrap(err, messageFailedToFetchModel)
+	}
+	return item, nil
+}
+
+func (repo *PGRepository) MarkAsUsed(ctx context.Context, id int) (bool, error) {
+	res, err := repo.
+		Model(&model.UserToken{}).
+		Context(ctx).
+		Set("used_at = ?", time.Now()).
+		Where(gopgutil.BuildConditionEquals("id"), id).
+		Where("used_at IS NULL").
+		Update()
+	if err != nil && err != pg.ErrNoRows {
+		return false, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return res != nil && res.RowsAffected() > 0, nil
+}
+
+func (repo *PGRepository) Redeem(ctx context.Context, id int, input *model.UserInput) (bool, error) {
+	redeemed := false
+	err := repo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		token := &model.UserToken{}
+		res, err := tx.
+			Model(token).
+			Context(ctx).
+			Set("used_at = ?", time.Now()).
+			Where(gopgutil.BuildConditionEquals("id"), id).
+			Where("used_at IS NULL").
+			Returning("user_id").
+			Update()
+		if err != nil && err != pg.ErrNoRows {
+			return err
+		}
+		if res == nil || res.RowsAffected() == 0 {
+			return nil
+		}
+
+		if _, err := tx.
+			Model(&model.User{}).
+			Context(ctx).
+			Apply(input.ApplyUpdate).
+			Where(gopgutil.BuildConditionEquals("id"), token.UserID).
+			Update(); err != nil {
+			return err
+		}
+		redeemed = true
+		return nil
+	})
+	if err != nil {
+		return false, errorutil.Wrap(err, messageFailedToSaveModel)
+	}
+	return redeemed, nil
+}
+
+func (repo *PGRepository) Delete(ctx context.Context, f *model.UserTokenFilter) ([]*model.UserToken, error) {
+	items := make([]*model.UserToken, 0)
+	if _, err := repo.
+		Model(&items).
+		Context(ctx).
+		Returning("*").
+		Apply(f.Where).
+		Delete(); err != nil && err != pg.ErrNoRows {
+		return nil, errorutil.Wrap(err, messageFailedToDeleteModel)
+	}
+	return items, nil
+}
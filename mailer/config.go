@@ -0,0 +1,14 @@
+package mailer
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type FileConfig struct {
+	Path string
+	From string
+}
@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// fileMailer saves each message as a separate .eml file. It's meant to be used during local development.
+type fileMailer struct {
+	path string
+	from string
+}
+
+func NewFileMailer(cfg *FileConfig) (Mailer, error) {
+	if cfg == nil || cfg.Path == "" {
+		return nil, errors.New("cfg.Path is required")
+	}
+	if err := os.MkdirAll(cfg.Path, 0755); err != nil {
+		return nil, errors.Wrap(err, "couldn't create the directory")
+	}
+	return &fileMailer{
+		path: cfg.Path,
+		from: cfg.From,
+	}, nil
+}
+
+func (m *fileMailer) Send(_ context.Context, msg *Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+	b, err := msg.bytes(m.from)
+	if err != nil {
+		return err
+	}
+	filename := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + uuid.New().String() + ".eml"
+	if err := os.WriteFile(path.Join(m.path, filename), b, 0644); err != nil {
+		return errors.Wrap(err, "couldn't write a message")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// logMailer doesn't send anything, it only logs messages. It's meant to be used during local development.
+type logMailer struct {
+	log logrus.FieldLogger
+}
+
+func NewLogMailer(log logrus.FieldLogger) Mailer {
+	if log == nil {
+		log = logrus.StandardLogger()
+	}
+	return &logMailer{
+		log: log,
+	}
+}
+
+func (m *logMailer) Send(_ context.Context, msg *Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+	m.log.
+		WithField("to", msg.To).
+		WithField("subject", msg.Subject).
+		WithField("text", msg.Text).
+		Info("Message has been sent")
+	return nil
+}
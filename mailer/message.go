@@ -0,0 +1,84 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (msg *Message) validate() error {
+	if msg == nil {
+		return errors.New("message is required")
+	}
+	if len(msg.To) == 0 {
+		return errors.New("message must have at least one recipient")
+	}
+	if msg.Text == "" && msg.HTML == "" {
+		return errors.New("message body is required")
+	}
+	return nil
+}
+
+// bytes returns the message encoded in the RFC 5322 format.
+func (msg *Message) bytes(from string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "From: %s\r\n", from)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(buf, "Message-ID: <%s@%s>\r\n", uuid.New().String(), domainFromAddress(from))
+	fmt.Fprintf(buf, "MIME-Version: 1.0\r\n")
+
+	if msg.HTML == "" || msg.Text == "" {
+		contentType := "text/plain"
+		body := msg.Text
+		if msg.HTML != "" {
+			contentType = "text/html"
+			body = msg.HTML
+		}
+		fmt.Fprintf(buf, "Content-Type: %s; charset=utf-8\r\n", contentType)
+		fmt.Fprintf(buf, "Content-Transfer-Encoding: 8bit\r\n\r\n")
+		buf.WriteString(body)
+		return buf.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(buf)
+	fmt.Fprintf(buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", w.Boundary())
+	for _, part := range [...]struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain", msg.Text},
+		{"text/html", msg.HTML},
+	} {
+		pw, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't create a message part")
+		}
+		if _, err := pw.Write([]byte(part.body)); err != nil {
+			return nil, errors.Wrap(err, "couldn't write a message part")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "couldn't close the multipart writer")
+	}
+	return buf.Bytes(), nil
+}
+
+func domainFromAddress(address string) string {
+	address = strings.TrimSuffix(address, ">")
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
+}
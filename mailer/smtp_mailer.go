@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(cfg *SMTPConfig) (Mailer, error) {
+	if cfg == nil || cfg.Host == "" {
+		return nil, errors.New("cfg.Host is required")
+	}
+	if cfg.From == "" {
+		return nil, errors.New("cfg.From is required")
+	}
+	port := cfg.Port
+	if port <= 0 {
+		port = 587
+	}
+	m := &smtpMailer{
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		from: cfg.From,
+	}
+	if cfg.Username != "" {
+		m.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return m, nil
+}
+
+func (m *smtpMailer) Send(_ context.Context, msg *Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+	b, err := msg.bytes(m.from)
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	if err := smtp.SendMail(m.addr, m.auth, from.Address, msg.To, b); err != nil {
+		return errors.Wrap(err, "couldn't send a message")
+	}
+	return nil
+}
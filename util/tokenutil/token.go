@@ -0,0 +1,29 @@
+package tokenutil
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultLength = 32
+)
+
+// Generate returns a random, URL-safe token built from n random bytes.
+func Generate(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "couldn't generate a token")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash returns a hex-encoded SHA-256 hash of the given token, which is safe to store in the database.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}